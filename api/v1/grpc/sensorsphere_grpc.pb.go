@@ -24,10 +24,32 @@ const _ = grpc.SupportPackageIsVersion7
 type SensorSphereServiceClient interface {
 	CreateSensor(ctx context.Context, in *Sensor, opts ...grpc.CallOption) (*Sensor, error)
 	GetSensor(ctx context.Context, in *GetSensorRequest, opts ...grpc.CallOption) (*Sensor, error)
+	ListSensors(ctx context.Context, in *ListSensorsRequest, opts ...grpc.CallOption) (*ListSensorsResponse, error)
 	UpdateSensor(ctx context.Context, in *Sensor, opts ...grpc.CallOption) (*UpdateSensorResponse, error)
+	DeleteSensor(ctx context.Context, in *DeleteSensorRequest, opts ...grpc.CallOption) (*DeleteSensorResponse, error)
 	GetNearestSensor(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Sensor, error)
+	FindNearestSensors(ctx context.Context, in *FindNearestSensorsRequest, opts ...grpc.CallOption) (*FindNearestSensorsResponse, error)
+	FindSensorsWithinRadius(ctx context.Context, in *FindSensorsWithinRadiusRequest, opts ...grpc.CallOption) (*FindSensorsWithinRadiusResponse, error)
+	FindSensorsInArea(ctx context.Context, in *FindSensorsInAreaRequest, opts ...grpc.CallOption) (*ListSensorsResponse, error)
 	CreateSensorReading(ctx context.Context, in *SensorReading, opts ...grpc.CallOption) (*SensorReading, error)
+	CreateSensorReadings(ctx context.Context, in *CreateSensorReadingsRequest, opts ...grpc.CallOption) (*CreateSensorReadingsResponse, error)
+	FlagSensorReadings(ctx context.Context, in *FlagSensorReadingsRequest, opts ...grpc.CallOption) (*FlagSensorReadingsResponse, error)
+	StreamSensorReadings(ctx context.Context, opts ...grpc.CallOption) (SensorSphereService_StreamSensorReadingsClient, error)
 	GetSensorReadingsForTimeRange(ctx context.Context, in *TimeRangeQuery, opts ...grpc.CallOption) (*SensorReadingsResponse, error)
+	ExportSensorReadings(ctx context.Context, in *ExportSensorReadingsRequest, opts ...grpc.CallOption) (SensorSphereService_ExportSensorReadingsClient, error)
+	WatchSensorReadings(ctx context.Context, in *WatchSensorReadingsRequest, opts ...grpc.CallOption) (SensorSphereService_WatchSensorReadingsClient, error)
+	GetSensorReadingSeries(ctx context.Context, in *SeriesQuery, opts ...grpc.CallOption) (*SeriesResponse, error)
+	GetLatestReadings(ctx context.Context, in *GetLatestReadingsRequest, opts ...grpc.CallOption) (*SensorReadingsResponse, error)
+	AggregateSensorReadings(ctx context.Context, in *AggregationQuery, opts ...grpc.CallOption) (*AggregationResponse, error)
+	ResampleSensorReadings(ctx context.Context, in *ResampleQuery, opts ...grpc.CallOption) (*ResampleResponse, error)
+	InterpolateSensorReadings(ctx context.Context, in *InterpolateSensorReadingsRequest, opts ...grpc.CallOption) (*InterpolateSensorReadingsResponse, error)
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *RetentionPolicy, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error)
+	ApplyRetention(ctx context.Context, in *ApplyRetentionRequest, opts ...grpc.CallOption) (*RetentionReport, error)
+	GetCompressionSettings(ctx context.Context, in *GetCompressionSettingsRequest, opts ...grpc.CallOption) (*CompressionSettings, error)
+	SetCompression(ctx context.Context, in *SetCompressionRequest, opts ...grpc.CallOption) (*CompressionSettings, error)
+	ListChunkCompression(ctx context.Context, in *ListChunkCompressionRequest, opts ...grpc.CallOption) (*ListChunkCompressionResponse, error)
 }
 
 type sensorSphereServiceClient struct {
@@ -56,6 +78,15 @@ func (c *sensorSphereServiceClient) GetSensor(ctx context.Context, in *GetSensor
 	return out, nil
 }
 
+func (c *sensorSphereServiceClient) ListSensors(ctx context.Context, in *ListSensorsRequest, opts ...grpc.CallOption) (*ListSensorsResponse, error) {
+	out := new(ListSensorsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/ListSensors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sensorSphereServiceClient) UpdateSensor(ctx context.Context, in *Sensor, opts ...grpc.CallOption) (*UpdateSensorResponse, error) {
 	out := new(UpdateSensorResponse)
 	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/UpdateSensor", in, out, opts...)
@@ -65,6 +96,15 @@ func (c *sensorSphereServiceClient) UpdateSensor(ctx context.Context, in *Sensor
 	return out, nil
 }
 
+func (c *sensorSphereServiceClient) DeleteSensor(ctx context.Context, in *DeleteSensorRequest, opts ...grpc.CallOption) (*DeleteSensorResponse, error) {
+	out := new(DeleteSensorResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/DeleteSensor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sensorSphereServiceClient) GetNearestSensor(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Sensor, error) {
 	out := new(Sensor)
 	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/GetNearestSensor", in, out, opts...)
@@ -74,6 +114,33 @@ func (c *sensorSphereServiceClient) GetNearestSensor(ctx context.Context, in *Lo
 	return out, nil
 }
 
+func (c *sensorSphereServiceClient) FindNearestSensors(ctx context.Context, in *FindNearestSensorsRequest, opts ...grpc.CallOption) (*FindNearestSensorsResponse, error) {
+	out := new(FindNearestSensorsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/FindNearestSensors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) FindSensorsWithinRadius(ctx context.Context, in *FindSensorsWithinRadiusRequest, opts ...grpc.CallOption) (*FindSensorsWithinRadiusResponse, error) {
+	out := new(FindSensorsWithinRadiusResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/FindSensorsWithinRadius", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) FindSensorsInArea(ctx context.Context, in *FindSensorsInAreaRequest, opts ...grpc.CallOption) (*ListSensorsResponse, error) {
+	out := new(ListSensorsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/FindSensorsInArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sensorSphereServiceClient) CreateSensorReading(ctx context.Context, in *SensorReading, opts ...grpc.CallOption) (*SensorReading, error) {
 	out := new(SensorReading)
 	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/CreateSensorReading", in, out, opts...)
@@ -83,6 +150,58 @@ func (c *sensorSphereServiceClient) CreateSensorReading(ctx context.Context, in
 	return out, nil
 }
 
+func (c *sensorSphereServiceClient) CreateSensorReadings(ctx context.Context, in *CreateSensorReadingsRequest, opts ...grpc.CallOption) (*CreateSensorReadingsResponse, error) {
+	out := new(CreateSensorReadingsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/CreateSensorReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) FlagSensorReadings(ctx context.Context, in *FlagSensorReadingsRequest, opts ...grpc.CallOption) (*FlagSensorReadingsResponse, error) {
+	out := new(FlagSensorReadingsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/FlagSensorReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) StreamSensorReadings(ctx context.Context, opts ...grpc.CallOption) (SensorSphereService_StreamSensorReadingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SensorSphereService_ServiceDesc.Streams[0], "/sensorsphere.v1.SensorSphereService/StreamSensorReadings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sensorSphereServiceStreamSensorReadingsClient{stream}
+	return x, nil
+}
+
+type SensorSphereService_StreamSensorReadingsClient interface {
+	Send(*SensorReading) error
+	CloseAndRecv() (*IngestSummary, error)
+	grpc.ClientStream
+}
+
+type sensorSphereServiceStreamSensorReadingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sensorSphereServiceStreamSensorReadingsClient) Send(m *SensorReading) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sensorSphereServiceStreamSensorReadingsClient) CloseAndRecv() (*IngestSummary, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(IngestSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sensorSphereServiceClient) GetSensorReadingsForTimeRange(ctx context.Context, in *TimeRangeQuery, opts ...grpc.CallOption) (*SensorReadingsResponse, error) {
 	out := new(SensorReadingsResponse)
 	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/GetSensorReadingsForTimeRange", in, out, opts...)
@@ -92,16 +211,210 @@ func (c *sensorSphereServiceClient) GetSensorReadingsForTimeRange(ctx context.Co
 	return out, nil
 }
 
+func (c *sensorSphereServiceClient) ExportSensorReadings(ctx context.Context, in *ExportSensorReadingsRequest, opts ...grpc.CallOption) (SensorSphereService_ExportSensorReadingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SensorSphereService_ServiceDesc.Streams[1], "/sensorsphere.v1.SensorSphereService/ExportSensorReadings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sensorSphereServiceExportSensorReadingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SensorSphereService_ExportSensorReadingsClient interface {
+	Recv() (*SensorReadingsResponse, error)
+	grpc.ClientStream
+}
+
+type sensorSphereServiceExportSensorReadingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sensorSphereServiceExportSensorReadingsClient) Recv() (*SensorReadingsResponse, error) {
+	m := new(SensorReadingsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sensorSphereServiceClient) WatchSensorReadings(ctx context.Context, in *WatchSensorReadingsRequest, opts ...grpc.CallOption) (SensorSphereService_WatchSensorReadingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SensorSphereService_ServiceDesc.Streams[2], "/sensorsphere.v1.SensorSphereService/WatchSensorReadings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sensorSphereServiceWatchSensorReadingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SensorSphereService_WatchSensorReadingsClient interface {
+	Recv() (*SensorReading, error)
+	grpc.ClientStream
+}
+
+type sensorSphereServiceWatchSensorReadingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sensorSphereServiceWatchSensorReadingsClient) Recv() (*SensorReading, error) {
+	m := new(SensorReading)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sensorSphereServiceClient) GetSensorReadingSeries(ctx context.Context, in *SeriesQuery, opts ...grpc.CallOption) (*SeriesResponse, error) {
+	out := new(SeriesResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/GetSensorReadingSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) GetLatestReadings(ctx context.Context, in *GetLatestReadingsRequest, opts ...grpc.CallOption) (*SensorReadingsResponse, error) {
+	out := new(SensorReadingsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/GetLatestReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) AggregateSensorReadings(ctx context.Context, in *AggregationQuery, opts ...grpc.CallOption) (*AggregationResponse, error) {
+	out := new(AggregationResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/AggregateSensorReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) ResampleSensorReadings(ctx context.Context, in *ResampleQuery, opts ...grpc.CallOption) (*ResampleResponse, error) {
+	out := new(ResampleResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/ResampleSensorReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) InterpolateSensorReadings(ctx context.Context, in *InterpolateSensorReadingsRequest, opts ...grpc.CallOption) (*InterpolateSensorReadingsResponse, error) {
+	out := new(InterpolateSensorReadingsResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/InterpolateSensorReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/ListRetentionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) SetRetentionPolicy(ctx context.Context, in *RetentionPolicy, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error) {
+	out := new(DeleteRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/DeleteRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) ApplyRetention(ctx context.Context, in *ApplyRetentionRequest, opts ...grpc.CallOption) (*RetentionReport, error) {
+	out := new(RetentionReport)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/ApplyRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) GetCompressionSettings(ctx context.Context, in *GetCompressionSettingsRequest, opts ...grpc.CallOption) (*CompressionSettings, error) {
+	out := new(CompressionSettings)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/GetCompressionSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) SetCompression(ctx context.Context, in *SetCompressionRequest, opts ...grpc.CallOption) (*CompressionSettings, error) {
+	out := new(CompressionSettings)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/SetCompression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorSphereServiceClient) ListChunkCompression(ctx context.Context, in *ListChunkCompressionRequest, opts ...grpc.CallOption) (*ListChunkCompressionResponse, error) {
+	out := new(ListChunkCompressionResponse)
+	err := c.cc.Invoke(ctx, "/sensorsphere.v1.SensorSphereService/ListChunkCompression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SensorSphereServiceServer is the server API for SensorSphereService service.
 // All implementations must embed UnimplementedSensorSphereServiceServer
 // for forward compatibility
 type SensorSphereServiceServer interface {
 	CreateSensor(context.Context, *Sensor) (*Sensor, error)
 	GetSensor(context.Context, *GetSensorRequest) (*Sensor, error)
+	ListSensors(context.Context, *ListSensorsRequest) (*ListSensorsResponse, error)
 	UpdateSensor(context.Context, *Sensor) (*UpdateSensorResponse, error)
+	DeleteSensor(context.Context, *DeleteSensorRequest) (*DeleteSensorResponse, error)
 	GetNearestSensor(context.Context, *Location) (*Sensor, error)
+	FindNearestSensors(context.Context, *FindNearestSensorsRequest) (*FindNearestSensorsResponse, error)
+	FindSensorsWithinRadius(context.Context, *FindSensorsWithinRadiusRequest) (*FindSensorsWithinRadiusResponse, error)
+	FindSensorsInArea(context.Context, *FindSensorsInAreaRequest) (*ListSensorsResponse, error)
 	CreateSensorReading(context.Context, *SensorReading) (*SensorReading, error)
+	CreateSensorReadings(context.Context, *CreateSensorReadingsRequest) (*CreateSensorReadingsResponse, error)
+	FlagSensorReadings(context.Context, *FlagSensorReadingsRequest) (*FlagSensorReadingsResponse, error)
+	StreamSensorReadings(SensorSphereService_StreamSensorReadingsServer) error
 	GetSensorReadingsForTimeRange(context.Context, *TimeRangeQuery) (*SensorReadingsResponse, error)
+	ExportSensorReadings(*ExportSensorReadingsRequest, SensorSphereService_ExportSensorReadingsServer) error
+	WatchSensorReadings(*WatchSensorReadingsRequest, SensorSphereService_WatchSensorReadingsServer) error
+	GetSensorReadingSeries(context.Context, *SeriesQuery) (*SeriesResponse, error)
+	GetLatestReadings(context.Context, *GetLatestReadingsRequest) (*SensorReadingsResponse, error)
+	AggregateSensorReadings(context.Context, *AggregationQuery) (*AggregationResponse, error)
+	ResampleSensorReadings(context.Context, *ResampleQuery) (*ResampleResponse, error)
+	InterpolateSensorReadings(context.Context, *InterpolateSensorReadingsRequest) (*InterpolateSensorReadingsResponse, error)
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	SetRetentionPolicy(context.Context, *RetentionPolicy) (*RetentionPolicy, error)
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error)
+	ApplyRetention(context.Context, *ApplyRetentionRequest) (*RetentionReport, error)
+	GetCompressionSettings(context.Context, *GetCompressionSettingsRequest) (*CompressionSettings, error)
+	SetCompression(context.Context, *SetCompressionRequest) (*CompressionSettings, error)
+	ListChunkCompression(context.Context, *ListChunkCompressionRequest) (*ListChunkCompressionResponse, error)
 	mustEmbedUnimplementedSensorSphereServiceServer()
 }
 
@@ -115,18 +428,84 @@ func (UnimplementedSensorSphereServiceServer) CreateSensor(context.Context, *Sen
 func (UnimplementedSensorSphereServiceServer) GetSensor(context.Context, *GetSensorRequest) (*Sensor, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSensor not implemented")
 }
+func (UnimplementedSensorSphereServiceServer) ListSensors(context.Context, *ListSensorsRequest) (*ListSensorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSensors not implemented")
+}
 func (UnimplementedSensorSphereServiceServer) UpdateSensor(context.Context, *Sensor) (*UpdateSensorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSensor not implemented")
 }
+func (UnimplementedSensorSphereServiceServer) DeleteSensor(context.Context, *DeleteSensorRequest) (*DeleteSensorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSensor not implemented")
+}
 func (UnimplementedSensorSphereServiceServer) GetNearestSensor(context.Context, *Location) (*Sensor, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNearestSensor not implemented")
 }
+func (UnimplementedSensorSphereServiceServer) FindNearestSensors(context.Context, *FindNearestSensorsRequest) (*FindNearestSensorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearestSensors not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) FindSensorsWithinRadius(context.Context, *FindSensorsWithinRadiusRequest) (*FindSensorsWithinRadiusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSensorsWithinRadius not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) FindSensorsInArea(context.Context, *FindSensorsInAreaRequest) (*ListSensorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSensorsInArea not implemented")
+}
 func (UnimplementedSensorSphereServiceServer) CreateSensorReading(context.Context, *SensorReading) (*SensorReading, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSensorReading not implemented")
 }
+func (UnimplementedSensorSphereServiceServer) CreateSensorReadings(context.Context, *CreateSensorReadingsRequest) (*CreateSensorReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) FlagSensorReadings(context.Context, *FlagSensorReadingsRequest) (*FlagSensorReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) StreamSensorReadings(SensorSphereService_StreamSensorReadingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSensorReadings not implemented")
+}
 func (UnimplementedSensorSphereServiceServer) GetSensorReadingsForTimeRange(context.Context, *TimeRangeQuery) (*SensorReadingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSensorReadingsForTimeRange not implemented")
 }
+func (UnimplementedSensorSphereServiceServer) ExportSensorReadings(*ExportSensorReadingsRequest, SensorSphereService_ExportSensorReadingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) WatchSensorReadings(*WatchSensorReadingsRequest, SensorSphereService_WatchSensorReadingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) GetSensorReadingSeries(context.Context, *SeriesQuery) (*SeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSensorReadingSeries not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) GetLatestReadings(context.Context, *GetLatestReadingsRequest) (*SensorReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) AggregateSensorReadings(context.Context, *AggregationQuery) (*AggregationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) ResampleSensorReadings(context.Context, *ResampleQuery) (*ResampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResampleSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) InterpolateSensorReadings(context.Context, *InterpolateSensorReadingsRequest) (*InterpolateSensorReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterpolateSensorReadings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetentionPolicies not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) SetRetentionPolicy(context.Context, *RetentionPolicy) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) ApplyRetention(context.Context, *ApplyRetentionRequest) (*RetentionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRetention not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) GetCompressionSettings(context.Context, *GetCompressionSettingsRequest) (*CompressionSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompressionSettings not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) SetCompression(context.Context, *SetCompressionRequest) (*CompressionSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompression not implemented")
+}
+func (UnimplementedSensorSphereServiceServer) ListChunkCompression(context.Context, *ListChunkCompressionRequest) (*ListChunkCompressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChunkCompression not implemented")
+}
 func (UnimplementedSensorSphereServiceServer) mustEmbedUnimplementedSensorSphereServiceServer() {}
 
 // UnsafeSensorSphereServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -176,6 +555,24 @@ func _SensorSphereService_GetSensor_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorSphereService_ListSensors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSensorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).ListSensors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/ListSensors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).ListSensors(ctx, req.(*ListSensorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SensorSphereService_UpdateSensor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Sensor)
 	if err := dec(in); err != nil {
@@ -194,6 +591,24 @@ func _SensorSphereService_UpdateSensor_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorSphereService_DeleteSensor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSensorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).DeleteSensor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/DeleteSensor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).DeleteSensor(ctx, req.(*DeleteSensorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SensorSphereService_GetNearestSensor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Location)
 	if err := dec(in); err != nil {
@@ -212,6 +627,60 @@ func _SensorSphereService_GetNearestSensor_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorSphereService_FindNearestSensors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearestSensorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).FindNearestSensors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/FindNearestSensors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).FindNearestSensors(ctx, req.(*FindNearestSensorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_FindSensorsWithinRadius_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSensorsWithinRadiusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).FindSensorsWithinRadius(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/FindSensorsWithinRadius",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).FindSensorsWithinRadius(ctx, req.(*FindSensorsWithinRadiusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_FindSensorsInArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSensorsInAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).FindSensorsInArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/FindSensorsInArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).FindSensorsInArea(ctx, req.(*FindSensorsInAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SensorSphereService_CreateSensorReading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SensorReading)
 	if err := dec(in); err != nil {
@@ -230,6 +699,68 @@ func _SensorSphereService_CreateSensorReading_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorSphereService_CreateSensorReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSensorReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).CreateSensorReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/CreateSensorReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).CreateSensorReadings(ctx, req.(*CreateSensorReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_FlagSensorReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagSensorReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).FlagSensorReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/FlagSensorReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).FlagSensorReadings(ctx, req.(*FlagSensorReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_StreamSensorReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SensorSphereServiceServer).StreamSensorReadings(&sensorSphereServiceStreamSensorReadingsServer{stream})
+}
+
+type SensorSphereService_StreamSensorReadingsServer interface {
+	SendAndClose(*IngestSummary) error
+	Recv() (*SensorReading, error)
+	grpc.ServerStream
+}
+
+type sensorSphereServiceStreamSensorReadingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sensorSphereServiceStreamSensorReadingsServer) SendAndClose(m *IngestSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sensorSphereServiceStreamSensorReadingsServer) Recv() (*SensorReading, error) {
+	m := new(SensorReading)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _SensorSphereService_GetSensorReadingsForTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TimeRangeQuery)
 	if err := dec(in); err != nil {
@@ -248,6 +779,264 @@ func _SensorSphereService_GetSensorReadingsForTimeRange_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorSphereService_ExportSensorReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSensorReadingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SensorSphereServiceServer).ExportSensorReadings(m, &sensorSphereServiceExportSensorReadingsServer{stream})
+}
+
+type SensorSphereService_ExportSensorReadingsServer interface {
+	Send(*SensorReadingsResponse) error
+	grpc.ServerStream
+}
+
+type sensorSphereServiceExportSensorReadingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sensorSphereServiceExportSensorReadingsServer) Send(m *SensorReadingsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SensorSphereService_WatchSensorReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSensorReadingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SensorSphereServiceServer).WatchSensorReadings(m, &sensorSphereServiceWatchSensorReadingsServer{stream})
+}
+
+type SensorSphereService_WatchSensorReadingsServer interface {
+	Send(*SensorReading) error
+	grpc.ServerStream
+}
+
+type sensorSphereServiceWatchSensorReadingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sensorSphereServiceWatchSensorReadingsServer) Send(m *SensorReading) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SensorSphereService_GetSensorReadingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).GetSensorReadingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/GetSensorReadingSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).GetSensorReadingSeries(ctx, req.(*SeriesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_GetLatestReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).GetLatestReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/GetLatestReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).GetLatestReadings(ctx, req.(*GetLatestReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_AggregateSensorReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregationQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).AggregateSensorReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/AggregateSensorReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).AggregateSensorReadings(ctx, req.(*AggregationQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_ResampleSensorReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResampleQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).ResampleSensorReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/ResampleSensorReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).ResampleSensorReadings(ctx, req.(*ResampleQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_InterpolateSensorReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterpolateSensorReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).InterpolateSensorReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/InterpolateSensorReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).InterpolateSensorReadings(ctx, req.(*InterpolateSensorReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).ListRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/ListRetentionPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetentionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).SetRetentionPolicy(ctx, req.(*RetentionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/DeleteRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_ApplyRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).ApplyRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/ApplyRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).ApplyRetention(ctx, req.(*ApplyRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_GetCompressionSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompressionSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).GetCompressionSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/GetCompressionSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).GetCompressionSettings(ctx, req.(*GetCompressionSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_SetCompression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).SetCompression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/SetCompression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).SetCompression(ctx, req.(*SetCompressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorSphereService_ListChunkCompression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunkCompressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorSphereServiceServer).ListChunkCompression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensorsphere.v1.SensorSphereService/ListChunkCompression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorSphereServiceServer).ListChunkCompression(ctx, req.(*ListChunkCompressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SensorSphereService_ServiceDesc is the grpc.ServiceDesc for SensorSphereService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,23 +1052,115 @@ var SensorSphereService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSensor",
 			Handler:    _SensorSphereService_GetSensor_Handler,
 		},
+		{
+			MethodName: "ListSensors",
+			Handler:    _SensorSphereService_ListSensors_Handler,
+		},
 		{
 			MethodName: "UpdateSensor",
 			Handler:    _SensorSphereService_UpdateSensor_Handler,
 		},
+		{
+			MethodName: "DeleteSensor",
+			Handler:    _SensorSphereService_DeleteSensor_Handler,
+		},
 		{
 			MethodName: "GetNearestSensor",
 			Handler:    _SensorSphereService_GetNearestSensor_Handler,
 		},
+		{
+			MethodName: "FindNearestSensors",
+			Handler:    _SensorSphereService_FindNearestSensors_Handler,
+		},
+		{
+			MethodName: "FindSensorsWithinRadius",
+			Handler:    _SensorSphereService_FindSensorsWithinRadius_Handler,
+		},
+		{
+			MethodName: "FindSensorsInArea",
+			Handler:    _SensorSphereService_FindSensorsInArea_Handler,
+		},
 		{
 			MethodName: "CreateSensorReading",
 			Handler:    _SensorSphereService_CreateSensorReading_Handler,
 		},
+		{
+			MethodName: "CreateSensorReadings",
+			Handler:    _SensorSphereService_CreateSensorReadings_Handler,
+		},
+		{
+			MethodName: "FlagSensorReadings",
+			Handler:    _SensorSphereService_FlagSensorReadings_Handler,
+		},
 		{
 			MethodName: "GetSensorReadingsForTimeRange",
 			Handler:    _SensorSphereService_GetSensorReadingsForTimeRange_Handler,
 		},
+		{
+			MethodName: "GetSensorReadingSeries",
+			Handler:    _SensorSphereService_GetSensorReadingSeries_Handler,
+		},
+		{
+			MethodName: "GetLatestReadings",
+			Handler:    _SensorSphereService_GetLatestReadings_Handler,
+		},
+		{
+			MethodName: "AggregateSensorReadings",
+			Handler:    _SensorSphereService_AggregateSensorReadings_Handler,
+		},
+		{
+			MethodName: "ResampleSensorReadings",
+			Handler:    _SensorSphereService_ResampleSensorReadings_Handler,
+		},
+		{
+			MethodName: "InterpolateSensorReadings",
+			Handler:    _SensorSphereService_InterpolateSensorReadings_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _SensorSphereService_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _SensorSphereService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _SensorSphereService_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ApplyRetention",
+			Handler:    _SensorSphereService_ApplyRetention_Handler,
+		},
+		{
+			MethodName: "GetCompressionSettings",
+			Handler:    _SensorSphereService_GetCompressionSettings_Handler,
+		},
+		{
+			MethodName: "SetCompression",
+			Handler:    _SensorSphereService_SetCompression_Handler,
+		},
+		{
+			MethodName: "ListChunkCompression",
+			Handler:    _SensorSphereService_ListChunkCompression_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSensorReadings",
+			Handler:       _SensorSphereService_StreamSensorReadings_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSensorReadings",
+			Handler:       _SensorSphereService_ExportSensorReadings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSensorReadings",
+			Handler:       _SensorSphereService_WatchSensorReadings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/grpc/sensorsphere.proto",
 }
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// newCompressionCmd manages compression of the readings hypertable through the HTTP API of a running server.
+func newCompressionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compression",
+		Short: "Manage compression of stored readings",
+	}
+	cmd.PersistentFlags().String("server", fmt.Sprintf("http://localhost:%d", HttpDefaultPort),
+		"Address of the SensorSphere HTTP API.")
+
+	status := &cobra.Command{
+		Use:   "status",
+		Short: "Show the compression settings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return callAPI(cmd, http.MethodGet, "/compression", nil)
+		},
+	}
+
+	enable := &cobra.Command{
+		Use:   "enable",
+		Short: "Compress chunks once they are older than --compress-after",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			compressAfter, err := cmd.Flags().GetDuration("compress-after")
+			if err != nil {
+				return err
+			}
+
+			return callAPI(cmd, http.MethodPut, "/compression",
+				models.SetCompressionRequest{Enabled: true, CompressAfter: models.Duration(compressAfter)})
+		},
+	}
+	enable.Flags().Duration("compress-after", 7*24*time.Hour, "Age at which a chunk is compressed.")
+
+	disable := &cobra.Command{
+		Use:   "disable",
+		Short: "Decompress every chunk and disable compression",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return callAPI(cmd, http.MethodPut, "/compression", models.SetCompressionRequest{})
+		},
+	}
+
+	chunks := &cobra.Command{
+		Use:   "chunks",
+		Short: "List chunks with their compression ratios",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return callAPI(cmd, http.MethodGet, "/compression/chunks", nil)
+		},
+	}
+
+	cmd.AddCommand(status, enable, disable, chunks)
+
+	return cmd
+}
+
+// callAPI sends body as JSON to the server named by the --server flag and prints the indented response.
+func callAPI(cmd *cobra.Command, method, path string, body interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	return sendAPIRequest(cmd, method, path, "application/json", &reqBody)
+}
+
+// sendAPIRequest streams body to the server named by the --server flag and prints the indented response.
+func sendAPIRequest(cmd *cobra.Command, method, path, contentType string, body io.Reader) error {
+	server, err := cmd.Flags().GetString("server")
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(cmd.Context(), method, strings.TrimSuffix(server, "/")+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s: %s", method, path, res.Status, strings.TrimSpace(string(resBody)))
+	}
+
+	var out bytes.Buffer
+	if err = json.Indent(&out, resBody, "", "  "); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), out.String())
+
+	return err
+}
@@ -16,9 +16,27 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/sensor_readings": {
+        "/compression": {
             "get": {
-                "description": "Get sensor readings for a specific time range",
+                "description": "Report whether the readings hypertable is compressed, how and when chunks get compressed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "compression"
+                ],
+                "summary": "Get the compression settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CompressionSettings"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Enable compression of the readings hypertable (segmented by sensor name, ordered by time) and\ncompress chunks once they are older than compressAfter, or decompress every chunk and disable it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -26,34 +44,77 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sensor_readings"
+                    "compression"
                 ],
-                "summary": "Get sensor readings for a time range",
+                "summary": "Configure compression",
                 "parameters": [
                     {
-                        "description": "Time range query",
-                        "name": "timeRangeQuery",
+                        "description": "Compression settings",
+                        "name": "settings",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.TimeRangeQuery"
+                            "$ref": "#/definitions/models.SetCompressionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CompressionSettings"
+                        }
+                    }
+                }
+            }
+        },
+        "/compression/chunks": {
+            "get": {
+                "description": "List the chunks of the readings hypertable, oldest first, with the compression ratio of the\ncompressed ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "compression"
+                ],
+                "summary": "List chunk compression",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ChunkCompression"
+                            }
                         }
                     }
+                }
+            }
+        },
+        "/retention_policies": {
+            "get": {
+                "description": "List the global, per-tag and per-sensor retention policies.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retention"
                 ],
+                "summary": "List retention policies",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.SensorReading"
+                                "$ref": "#/definitions/models.RetentionPolicy"
                             }
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create a new sensor reading with the input payload",
+            "put": {
+                "description": "Create or replace the retention policy for a scope and target. A sensor's readings are kept\nfor the maxAge of its sensor policy, else the longest of its tag policies, else the global policy.",
                 "consumes": [
                     "application/json"
                 ],
@@ -61,17 +122,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sensor_readings"
+                    "retention"
                 ],
-                "summary": "Create a new sensor reading",
+                "summary": "Set a retention policy",
                 "parameters": [
                     {
-                        "description": "Create sensor reading",
-                        "name": "sensorReading",
+                        "description": "Retention policy",
+                        "name": "policy",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SensorReading"
+                            "$ref": "#/definitions/models.RetentionPolicy"
                         }
                     }
                 ],
@@ -79,83 +140,87 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SensorReading"
+                            "$ref": "#/definitions/models.RetentionPolicy"
                         }
                     }
                 }
-            }
-        },
-        "/sensors": {
-            "post": {
-                "description": "Create a new sensor with the input payload",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Delete the retention policy for a scope and target.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sensors"
+                    "retention"
                 ],
-                "summary": "Create a new sensor",
+                "summary": "Delete a retention policy",
                 "parameters": [
                     {
-                        "description": "Create sensor",
-                        "name": "sensor",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Sensor"
-                        }
+                        "enum": [
+                            "global",
+                            "tag",
+                            "sensor"
+                        ],
+                        "type": "string",
+                        "description": "Policy scope",
+                        "name": "scope",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag or sensor name",
+                        "name": "target",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Sensor"
+                            "$ref": "#/definitions/models.DeleteRetentionPolicyRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Retention policy not found",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/sensors/nearest": {
-            "get": {
-                "description": "Get the nearest sensor to a specific location",
-                "consumes": [
-                    "application/json"
-                ],
+        "/retention_policies:apply": {
+            "post": {
+                "description": "Remove the readings older than their retention policy now instead of waiting for the agent's\nretention job. With dryRun the report lists what would be removed without removing it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sensors"
+                    "retention"
                 ],
-                "summary": "Get the nearest sensor",
+                "summary": "Apply the retention policies",
                 "parameters": [
                     {
-                        "description": "Location",
-                        "name": "location",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Location"
-                        }
+                        "type": "boolean",
+                        "description": "Only report what would be removed",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Sensor"
+                            "$ref": "#/definitions/models.RetentionReport"
                         }
                     }
                 }
             }
         },
-        "/sensors/{name}": {
+        "/sensor_readings": {
             "get": {
-                "description": "Get a sensor by its name",
+                "description": "Get a sensor's readings between startTime and endTime a page at a time, ordered by time.\nPass the returned nextPageToken as pageToken to fetch the following page.",
                 "consumes": [
                     "application/json"
                 ],
@@ -163,29 +228,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sensors"
+                    "sensor_readings"
                 ],
-                "summary": "Get a sensor",
+                "summary": "Get sensor readings for a time range",
                 "parameters": [
+                    {
+                        "description": "Time range query",
+                        "name": "timeRangeQuery",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TimeRangeQuery"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of readings to return (default 1000, max 10000)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Sensor name",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "Token from a previous page",
+                        "name": "pageToken",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order by time",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Leave out readings whose quality is not good",
+                        "name": "goodOnly",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Sensor"
+                            "$ref": "#/definitions/models.SensorReadingPage"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a sensor with the input payload",
+            "post": {
+                "description": "Create a new sensor reading with the input payload. The reading is stored at the supplied time,\nor at server time when none is given; times outside the configured window are rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -193,17 +288,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sensors"
+                    "sensor_readings"
                 ],
-                "summary": "Update a sensor",
+                "summary": "Create a new sensor reading",
                 "parameters": [
                     {
-                        "description": "Update sensor",
-                        "name": "sensor",
+                        "description": "Create sensor reading",
+                        "name": "sensorReading",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Sensor"
+                            "$ref": "#/definitions/models.SensorReading"
                         }
                     }
                 ],
@@ -211,82 +306,1919 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/models.SensorReading"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Sensor already has a reading at this time",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Reading time is outside the accepted window, or value outside the sensor's range",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/status": {
+        "/sensor_readings/aggregate": {
             "get": {
-                "description": "Returns 200 OK if server is ready to accept requests",
+                "description": "Summarise the readings in [startTime, endTime) in buckets of bucketWidth, for one sensor or for\nevery sensor carrying all of the given tags. Buckets without readings are left out.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "status"
+                    "sensor_readings"
+                ],
+                "summary": "Aggregate sensor readings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sensor name",
+                        "name": "sensorName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range (RFC 3339), inclusive",
+                        "name": "startTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range (RFC 3339), exclusive",
+                        "name": "endTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket width as a duration such as 15m or 1h",
+                        "name": "bucketWidth",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "avg",
+                                "min",
+                                "max",
+                                "sum",
+                                "count",
+                                "first",
+                                "last",
+                                "stddev"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Aggregates (default avg)",
+                        "name": "functions",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate good readings only, always from the raw readings",
+                        "name": "goodOnly",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get server status",
                 "responses": {
                     "200": {
-                        "description": "Server is running",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.AggregateBucket"
+                            }
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Location": {
-            "type": "object",
-            "properties": {
-                "latitude": {
-                    "type": "number"
-                },
-                "longitude": {
-                    "type": "number"
-                }
-            }
         },
-        "models.Sensor": {
-            "type": "object",
-            "properties": {
-                "location": {
-                    "$ref": "#/definitions/models.Location"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+        "/sensor_readings/export": {
+            "get": {
+                "description": "Stream the readings between startTime and endTime of the named sensors and/or of the sensors\nmatching the tag filters as CSV, newline-delimited JSON or Parquet, ordered by time. The format\nparameter overrides the Accept header; CSV is the default. Rows are written as they are read\nfrom the database, so exports of any size can be downloaded.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.apache.parquet"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Export sensor readings",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sensor names",
+                        "name": "sensorNames",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with at least one of these tags",
+                        "name": "anyTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "allTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range (inclusive), RFC 3339",
+                        "name": "startTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range (inclusive), RFC 3339",
+                        "name": "endTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order by time",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson",
+                            "parquet"
+                        ],
+                        "type": "string",
+                        "description": "Export format, overriding the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Leave out readings whose quality is not good",
+                        "name": "goodOnly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Readings",
+                        "schema": {
+                            "type": "file"
+                        }
                     }
                 }
             }
         },
-        "models.SensorReading": {
-            "type": "object",
-            "properties": {
-                "sensorName": {
-                    "type": "string"
-                },
-                "time": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "number"
-                }
-            }
-        },
-        "models.TimeRangeQuery": {
-            "type": "object",
-            "properties": {
+        "/sensor_readings/latest": {
+            "get": {
+                "description": "Get the most recent reading of each of the named sensors and/or of every sensor carrying all of\nthe given tags. Sensors without readings are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Get the latest readings",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sensor names (at most 1000)",
+                        "name": "sensorNames",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the latest good reading of each sensor",
+                        "name": "goodOnly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SensorReading"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings/series": {
+            "get": {
+                "description": "Get the readings between startTime and endTime of the named sensors and/or of the sensors\nmatching the tag filters, either grouped per sensor or as a wide table with a row per timestamp\nand a column per sensor. Queries selecting too many sensors or readings are rejected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Get readings of several sensors",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sensor names",
+                        "name": "sensorNames",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sensors with at least one of these tags",
+                        "name": "anyTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Sensors with all of these tags",
+                        "name": "allTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range (RFC 3339)",
+                        "name": "startTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range (RFC 3339)",
+                        "name": "endTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "grouped",
+                            "wide"
+                        ],
+                        "type": "string",
+                        "description": "Response layout (default grouped)",
+                        "name": "layout",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Leave out readings whose quality is not good",
+                        "name": "goodOnly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SeriesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings/stream": {
+            "get": {
+                "description": "Push newly stored readings as Server-Sent Events (\"reading\" events whose id is the time and\nescaped sensor name of the latest reading sent, separated by \"|\"). When since or a Last-Event-ID\nheader is given, the stored readings taken from since, or after the Last-Event-ID, are replayed\nfirst.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Stream new sensor readings",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only readings of these sensors",
+                        "name": "sensorName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only readings of sensors carrying all of these tags",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only readings of sensors inside minLongitude,minLatitude,maxLongitude,maxLatitude",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replay readings taken since this RFC 3339 time",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "drop",
+                            "disconnect"
+                        ],
+                        "type": "string",
+                        "description": "What to do when the client falls behind",
+                        "name": "slowConsumer",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings:batch": {
+            "post": {
+                "description": "Create up to 10000 sensor readings in one request. With atomic set, either every reading is\nstored or none is; otherwise valid readings are stored and invalid ones reported per item.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Create sensor readings in bulk",
+                "parameters": [
+                    {
+                        "description": "Sensor readings",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchSensorReadingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchSensorReadingsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings:flag": {
+            "post": {
+                "description": "Set the quality (good, suspect, bad or estimated) and annotation of a sensor's readings between\nstartTime and endTime (both inclusive), e.g. after reviewing them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Flag sensor readings",
+                "parameters": [
+                    {
+                        "description": "Readings to flag",
+                        "name": "flag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FlagReadingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FlagReadingsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings:import": {
+            "post": {
+                "description": "Load readings from a CSV file (with a header naming the sensor_name, time and value columns) or\nfrom newline-delimited JSON readings, sent as the request body or as the \"file\" part of a\nmultipart form. The format parameter overrides the Content-Type of the upload. Readings are\nwritten with COPY in batches as the upload is read, and the report lists the lines that were\nrejected. With createSensors, sensors that do not exist yet are created without a location;\nsoft-deleted sensors are not restored and their readings are rejected.",
+                "consumes": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Import sensor readings",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Format of the upload",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Create the sensors that do not exist yet",
+                        "name": "createSensors",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings:interpolate": {
+            "get": {
+                "description": "Estimate the value at a point and time from the readings of the k nearest sensors that have a\nreading within window of the time, using the reading of each closest to the time. Returns the\nestimate and the sensors it was made from, each with its distance, reading and weight.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Interpolate the value at a point",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Longitude of the point",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude of the point",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time of the estimate (RFC 3339), now by default",
+                        "name": "time",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "idw",
+                            "nearest",
+                            "kriging"
+                        ],
+                        "type": "string",
+                        "description": "Interpolation method (default idw)",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of sensors to interpolate from (default 8, max 100)",
+                        "name": "k",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How far from the time readings are used, as a duration (default 15m)",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only sensors within this many metres",
+                        "name": "maxDistance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only sensors measuring this",
+                        "name": "measurementType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with at least one of these tags",
+                        "name": "anyTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "allTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Leave out readings whose quality is not good",
+                        "name": "goodOnly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Inverse distance weighting exponent (default 2, max 10)",
+                        "name": "power",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Kriging practical range in metres (default the furthest the sensors are apart)",
+                        "name": "range",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Interpolation"
+                        }
+                    },
+                    "404": {
+                        "description": "No readings near the point and time",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Sensors report in different units or share a location",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensor_readings:resample": {
+            "get": {
+                "description": "Align a sensor's readings in [startTime, endTime) to one point every interval, averaging the\nreadings within an interval. Intervals without readings are filled with null, the last observed\nvalue (locf), a linear interpolation or fillValue, and flagged as synthesized.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensor_readings"
+                ],
+                "summary": "Resample sensor readings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sensor name",
+                        "name": "sensorName",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range (RFC 3339), inclusive",
+                        "name": "startTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range (RFC 3339), exclusive",
+                        "name": "endTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Spacing of the points as a duration such as 5m",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "null",
+                            "locf",
+                            "linear",
+                            "constant"
+                        ],
+                        "type": "string",
+                        "description": "Gap filling strategy (default null)",
+                        "name": "fill",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Value of unfilled points with the constant strategy",
+                        "name": "fillValue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Resample good readings only",
+                        "name": "goodOnly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ResampledReading"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensors": {
+            "get": {
+                "description": "List sensors a page at a time, optionally filtered by name prefix and tags.\nPass the returned nextPageToken as pageToken to fetch the following page. Clients accepting\napplication/geo+json get a FeatureCollection with the latest value of each sensor.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "List sensors",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of sensors to return (default 100, max 1000)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from a previous page",
+                        "name": "pageToken",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only sensors whose name starts with this prefix",
+                        "name": "namePrefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with at least one of these tags",
+                        "name": "anyTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "allTags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order by name",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SensorPage"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new sensor with the input payload, given as JSON or as a GeoJSON Feature with a Point\ngeometry whose properties hold the other fields.",
+                "consumes": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Create a new sensor",
+                "parameters": [
+                    {
+                        "description": "Create sensor",
+                        "name": "sensor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Sensor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Sensor"
+                        }
+                    },
+                    "409": {
+                        "description": "Sensor already exists or has been deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensors/nearest": {
+            "get": {
+                "description": "Get the nearest sensor to a specific location. Clients accepting application/geo+json get a\nFeatureCollection holding the sensor with the latest value it reported.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Get the nearest sensor",
+                "parameters": [
+                    {
+                        "description": "Location",
+                        "name": "location",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Location"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Sensor"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensors/search/area": {
+            "post": {
+                "description": "List the sensors inside a bounding box or a GeoJSON Polygon or MultiPolygon geometry (or a\nFeature holding one), boundary included, a page at a time ordered by name. Areas are taken to be\ndrawn on the longitude/latitude plane, and a bounding box whose minLongitude is greater than its\nmaxLongitude crosses the antimeridian. Pass the returned nextPageToken as pageToken to fetch the\nfollowing page. Clients accepting application/geo+json get a FeatureCollection with the latest\nvalue of each sensor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Find sensors in an area",
+                "parameters": [
+                    {
+                        "description": "Area to search",
+                        "name": "area",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AreaQuery"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SensorPage"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensors/within": {
+            "get": {
+                "description": "List the sensors within radius metres of a point a page at a time, nearest first, each with its\ndistance in metres. Pass the returned nextPageToken as pageToken to fetch the following page.\nClients accepting application/geo+json get a FeatureCollection with the latest value of each\nsensor.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Find sensors within a radius",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Longitude of the point",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude of the point",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Radius in metres",
+                        "name": "radius",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with at least one of these tags",
+                        "name": "anyTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "allTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of sensors to return (default 100, max 1000)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from a previous page",
+                        "name": "pageToken",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SensorDistancePage"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensors/{name}": {
+            "get": {
+                "description": "Get a sensor by its name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Get a sensor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sensor name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Sensor"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a sensor with the input payload, given as JSON or as a GeoJSON Feature with a Point\ngeometry whose properties hold the other fields.",
+                "consumes": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Update a sensor",
+                "parameters": [
+                    {
+                        "description": "Update sensor",
+                        "name": "sensor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Sensor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a sensor by its name. Mode \"restrict\" (default) refuses when the sensor has readings,\n\"cascade\" deletes its readings too and \"soft\" hides the sensor but keeps its readings.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Delete a sensor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sensor name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "restrict",
+                            "cascade",
+                            "soft"
+                        ],
+                        "type": "string",
+                        "description": "Delete mode",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteSensorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Sensor has readings",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/sensors:nearest": {
+            "get": {
+                "description": "List the k sensors nearest a point, nearest first, each with its distance in metres. Sensors\nfurther away than maxDistance metres, without the tags asked for, or whose latest reading is\nolder than reportedWithin are left out. Clients accepting application/geo+json get a\nFeatureCollection with the latest value of each sensor.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Find the k nearest sensors",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Longitude of the point",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude of the point",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of sensors to return (default 1, max 1000)",
+                        "name": "k",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only sensors within this many metres",
+                        "name": "maxDistance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with at least one of these tags",
+                        "name": "anyTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only sensors with all of these tags",
+                        "name": "allTags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only sensors with a reading this recent, as a duration such as 15m",
+                        "name": "reportedWithin",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NearestSensorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/status": {
+            "get": {
+                "description": "Returns 200 OK if server is ready to accept requests",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "status"
+                ],
+                "summary": "Get server status",
+                "responses": {
+                    "200": {
+                        "description": "Server is running",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AggregateBucket": {
+            "type": "object",
+            "properties": {
+                "time": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.AreaQuery": {
+            "type": "object",
+            "properties": {
+                "allTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "anyTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "bbox": {
+                    "$ref": "#/definitions/models.BoundingBox"
+                },
+                "geometry": {
+                    "type": "object"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "pageToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BatchItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "reading": {
+                    "$ref": "#/definitions/models.SensorReading"
+                }
+            }
+        },
+        "models.BatchSensorReadingsRequest": {
+            "type": "object",
+            "properties": {
+                "atomic": {
+                    "description": "Atomic writes either every reading or none of them. Otherwise every valid reading is written.",
+                    "type": "boolean"
+                },
+                "readings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SensorReading"
+                    }
+                }
+            }
+        },
+        "models.BatchSensorReadingsResponse": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "rejected": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BatchItemResult"
+                    }
+                }
+            }
+        },
+        "models.BoundingBox": {
+            "type": "object",
+            "properties": {
+                "maxLatitude": {
+                    "type": "number"
+                },
+                "maxLongitude": {
+                    "type": "number"
+                },
+                "minLatitude": {
+                    "type": "number"
+                },
+                "minLongitude": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ChunkCompression": {
+            "type": "object",
+            "properties": {
+                "bytesAfterCompression": {
+                    "type": "integer"
+                },
+                "bytesBeforeCompression": {
+                    "description": "BytesBeforeCompression, BytesAfterCompression and Ratio are only set for compressed chunks.",
+                    "type": "integer"
+                },
+                "chunk": {
+                    "type": "string"
+                },
+                "compressed": {
+                    "type": "boolean"
+                },
+                "rangeEnd": {
+                    "type": "string"
+                },
+                "rangeStart": {
+                    "type": "string"
+                },
+                "ratio": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CompressionSettings": {
+            "type": "object",
+            "properties": {
+                "compressAfter": {
+                    "description": "CompressAfter is the age at which the compression policy compresses a chunk. It is zero when no policy\nis scheduled.",
+                    "type": "string",
+                    "example": "168h"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "orderBy": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "segmentBy": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.DeleteMode": {
+            "type": "string",
+            "enum": [
+                "restrict",
+                "cascade",
+                "soft"
+            ],
+            "x-enum-varnames": [
+                "DeleteModeRestrict",
+                "DeleteModeCascade",
+                "DeleteModeSoft"
+            ]
+        },
+        "models.DeleteRetentionPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "scope": {
+                    "$ref": "#/definitions/models.RetentionScope"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DeleteSensorResponse": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "$ref": "#/definitions/models.DeleteMode"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "readingsDeleted": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.FlagReadingsRequest": {
+            "type": "object",
+            "properties": {
+                "annotation": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "quality": {
+                    "$ref": "#/definitions/models.Quality"
+                },
+                "sensorName": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FlagReadingsResponse": {
+            "type": "object",
+            "properties": {
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ImportRejection": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ImportReport": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "createdSensors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rejected": {
+                    "type": "integer"
+                },
+                "rejections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportRejection"
+                    }
+                },
+                "rejectionsTruncated": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.Interpolation": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "$ref": "#/definitions/models.InterpolationMethod"
+                },
+                "sensors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InterpolationSource"
+                    }
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "variance": {
+                    "description": "Variance is the kriging variance of the value.",
+                    "type": "number"
+                }
+            }
+        },
+        "models.InterpolationMethod": {
+            "type": "string",
+            "enum": [
+                "idw",
+                "nearest",
+                "kriging"
+            ],
+            "x-enum-varnames": [
+                "InterpolationIDW",
+                "InterpolationNearest",
+                "InterpolationKriging"
+            ]
+        },
+        "models.InterpolationSource": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.Location"
+                },
+                "sensorName": {
+                    "type": "string"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Location": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.NearestSensorsResponse": {
+            "type": "object",
+            "properties": {
+                "sensors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SensorDistance"
+                    }
+                }
+            }
+        },
+        "models.Quality": {
+            "type": "string",
+            "enum": [
+                "good",
+                "suspect",
+                "bad",
+                "estimated"
+            ],
+            "x-enum-varnames": [
+                "QualityGood",
+                "QualitySuspect",
+                "QualityBad",
+                "QualityEstimated"
+            ]
+        },
+        "models.RangePolicy": {
+            "type": "string",
+            "enum": [
+                "reject",
+                "flag"
+            ],
+            "x-enum-varnames": [
+                "RangePolicyReject",
+                "RangePolicyFlag"
+            ]
+        },
+        "models.ResampledReading": {
+            "type": "object",
+            "properties": {
+                "sensorName": {
+                    "type": "string"
+                },
+                "synthesized": {
+                    "description": "Synthesized marks points that had no readings and were filled in.",
+                    "type": "boolean"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is null when the point has no readings and the fill strategy is null, or nothing to fill from.",
+                    "type": "number"
+                }
+            }
+        },
+        "models.RetentionPolicy": {
+            "type": "object",
+            "properties": {
+                "maxAge": {
+                    "type": "string",
+                    "example": "720h"
+                },
+                "scope": {
+                    "$ref": "#/definitions/models.RetentionScope"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RetentionReport": {
+            "type": "object",
+            "properties": {
+                "droppedChunks": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "readingsDeleted": {
+                    "type": "integer"
+                },
+                "sensors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SensorRetention"
+                    }
+                }
+            }
+        },
+        "models.RetentionScope": {
+            "type": "string",
+            "enum": [
+                "global",
+                "tag",
+                "sensor"
+            ],
+            "x-enum-varnames": [
+                "RetentionScopeGlobal",
+                "RetentionScopeTag",
+                "RetentionScopeSensor"
+            ]
+        },
+        "models.Sensor": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "$ref": "#/definitions/models.Location"
+                },
+                "maxValue": {
+                    "type": "number"
+                },
+                "measurementType": {
+                    "type": "string"
+                },
+                "minValue": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rangePolicy": {
+                    "$ref": "#/definitions/models.RangePolicy"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SensorDistance": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.Location"
+                },
+                "maxValue": {
+                    "type": "number"
+                },
+                "measurementType": {
+                    "type": "string"
+                },
+                "minValue": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rangePolicy": {
+                    "$ref": "#/definitions/models.RangePolicy"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SensorDistancePage": {
+            "type": "object",
+            "properties": {
+                "nextPageToken": {
+                    "type": "string"
+                },
+                "sensors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SensorDistance"
+                    }
+                }
+            }
+        },
+        "models.SensorPage": {
+            "type": "object",
+            "properties": {
+                "nextPageToken": {
+                    "type": "string"
+                },
+                "sensors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Sensor"
+                    }
+                }
+            }
+        },
+        "models.SensorReading": {
+            "type": "object",
+            "properties": {
+                "annotation": {
+                    "type": "string"
+                },
+                "outOfRange": {
+                    "type": "boolean"
+                },
+                "quality": {
+                    "$ref": "#/definitions/models.Quality"
+                },
+                "sensorName": {
+                    "type": "string"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SensorReadingPage": {
+            "type": "object",
+            "properties": {
+                "nextPageToken": {
+                    "type": "string"
+                },
+                "sensorReadings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SensorReading"
+                    }
+                }
+            }
+        },
+        "models.SensorRetention": {
+            "type": "object",
+            "properties": {
+                "cutoff": {
+                    "type": "string"
+                },
+                "maxAge": {
+                    "type": "string",
+                    "example": "720h"
+                },
+                "readingsDeleted": {
+                    "type": "integer"
+                },
+                "sensorName": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Series": {
+            "type": "object",
+            "properties": {
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SeriesPoint"
+                    }
+                },
+                "sensorName": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SeriesLayout": {
+            "type": "string",
+            "enum": [
+                "grouped",
+                "wide"
+            ],
+            "x-enum-varnames": [
+                "SeriesLayoutGrouped",
+                "SeriesLayoutWide"
+            ]
+        },
+        "models.SeriesPoint": {
+            "type": "object",
+            "properties": {
+                "time": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SeriesResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "layout": {
+                    "$ref": "#/definitions/models.SeriesLayout"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WideRow"
+                    }
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Series"
+                    }
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.SetCompressionRequest": {
+            "type": "object",
+            "properties": {
+                "compressAfter": {
+                    "type": "string",
+                    "example": "168h"
+                },
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.SortOrder": {
+            "type": "string",
+            "enum": [
+                "asc",
+                "desc"
+            ],
+            "x-enum-varnames": [
+                "SortOrderAsc",
+                "SortOrderDesc"
+            ]
+        },
+        "models.TimeRangeQuery": {
+            "type": "object",
+            "properties": {
                 "endTime": {
                     "type": "string"
                 },
+                "goodOnly": {
+                    "description": "GoodOnly leaves out readings whose quality is not good.",
+                    "type": "boolean"
+                },
+                "order": {
+                    "$ref": "#/definitions/models.SortOrder"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "pageToken": {
+                    "type": "string"
+                },
                 "sensorName": {
                     "type": "string"
                 },
@@ -294,6 +2226,20 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "models.WideRow": {
+            "type": "object",
+            "properties": {
+                "time": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// importContentTypes maps the import formats to the content type their files are uploaded with.
+var importContentTypes = map[string]string{
+	"csv":    "text/csv",
+	"ndjson": "application/x-ndjson",
+}
+
+// newImportCmd uploads a file of readings to the import endpoint of a running server.
+func newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import readings from a CSV or NDJSON file",
+		Long: "Import readings from a CSV file, whose header names the sensor_name, time and value columns, or " +
+			"from a file of newline-delimited JSON readings. Use - to read from standard input. The lines that " +
+			"were rejected are listed in the printed report.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			createSensors, err := cmd.Flags().GetBool("create-sensors")
+			if err != nil {
+				return err
+			}
+
+			if format == "" {
+				format = strings.TrimPrefix(strings.ToLower(filepath.Ext(args[0])), ".")
+				if format == "jsonl" {
+					format = "ndjson"
+				}
+			}
+
+			contentType, ok := importContentTypes[format]
+			if !ok {
+				return fmt.Errorf("cannot tell the format of %s, set --format to csv or ndjson", args[0])
+			}
+
+			file := os.Stdin
+			if args[0] != "-" {
+				if file, err = os.Open(args[0]); err != nil {
+					return err
+				}
+				defer file.Close()
+			}
+
+			query := url.Values{"format": {format}, "createSensors": {strconv.FormatBool(createSensors)}}
+
+			return sendAPIRequest(cmd, http.MethodPost, "/sensor_readings:import?"+query.Encode(), contentType, file)
+		},
+	}
+	cmd.Flags().String("format", "", "Format of the file, csv or ndjson. Defaults to the file extension.")
+	cmd.Flags().Bool("create-sensors", false, "Create the sensors that do not exist yet, without a location.")
+	cmd.Flags().String("server", fmt.Sprintf("http://localhost:%d", HttpDefaultPort),
+		"Address of the SensorSphere HTTP API.")
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -58,6 +59,13 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 		c.cfg.DbUser = viper.GetString("db-user")
 		c.cfg.DbPassword = viper.GetString("db-password")
 		c.cfg.DbPort = viper.GetInt("db-port")
+		c.cfg.ReadingMaxFuture = viper.GetDuration("reading-max-future")
+		c.cfg.ReadingMaxPast = viper.GetDuration("reading-max-past")
+		c.cfg.StreamBatchSize = viper.GetInt("stream-batch-size")
+		c.cfg.StreamFlushInterval = viper.GetDuration("stream-flush-interval")
+		c.cfg.MaxQuerySeries = viper.GetInt("max-query-series")
+		c.cfg.RetentionInterval = viper.GetDuration("retention-interval")
+		c.cfg.RetentionDryRun = viper.GetBool("retention-dry-run")
 		if viper.GetBool("enable-logging-middleware") {
 			// log each request with the global zap logger (initialized in server.NewHTTPServer)
 			c.cfg.MiddlewareFuncs = append(c.cfg.MiddlewareFuncs, middleware.LogRequest)
@@ -110,6 +118,18 @@ func setupFlags(cmd *cobra.Command) error {
 		cmd.Flags().String("cdb-port", "", "Database port.")
 		cmd.Flags().String("db-user", "", "Database username.")
 		cmd.Flags().String("db-password", "", "Database password.")
+		cmd.Flags().Duration("reading-max-future", 5*time.Minute,
+			"How far ahead of server time a reading timestamp may be (0 disables the check).")
+		cmd.Flags().Duration("reading-max-past", 0,
+			"How far behind server time a reading timestamp may be (0 disables the check).")
+		cmd.Flags().Int("stream-batch-size", 500, "Readings buffered per write by the Grpc ingest stream.")
+		cmd.Flags().Duration("stream-flush-interval", time.Second,
+			"Longest time the Grpc ingest stream buffers readings before writing them.")
+		cmd.Flags().Int("max-query-series", 100, "Most sensors a multi-sensor readings query may select.")
+		cmd.Flags().Duration("retention-interval", time.Hour,
+			"How often expired readings are removed according to the retention policies (0 disables it).")
+		cmd.Flags().Bool("retention-dry-run", false,
+			"Only log the readings the retention job would remove.")
 
 		return viper.BindPFlags(cmd.Flags())
 	}
@@ -128,6 +148,8 @@ func main() {
 	if err := setupFlags(cmd); err != nil {
 		log.Fatal(err)
 	}
+	cmd.AddCommand(newCompressionCmd())
+	cmd.AddCommand(newImportCmd())
 
 	if err := cmd.Execute(); err != nil {
 		log.Fatal(err)
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -17,6 +18,7 @@ import (
 	"github.com/koneal2013/sensorsphere/internal/auth"
 	"github.com/koneal2013/sensorsphere/internal/db"
 	"github.com/koneal2013/sensorsphere/internal/observability"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
 	"github.com/koneal2013/sensorsphere/internal/server"
 )
 
@@ -37,13 +39,28 @@ type Config struct {
 	DbPort                int
 	DbUser                string
 	DbPassword            string
+	ReadingMaxFuture      time.Duration
+	ReadingMaxPast        time.Duration
+	StreamBatchSize       int
+	StreamFlushInterval   time.Duration
+	// MaxQuerySeries caps how many sensors one multi-sensor readings query may select.
+	MaxQuerySeries int
+	// RetentionInterval is how often the retention policies are applied; 0 disables the retention job.
+	RetentionInterval time.Duration
+	// RetentionDryRun makes the retention job log what it would remove instead of removing it.
+	RetentionDryRun bool
 }
+
+// shutdownTimeout bounds how long Shutdown waits for requests and streams still in flight to finish.
+const shutdownTimeout = 10 * time.Second
+
 type Agent struct {
 	Config
 	traceProvider *trace.TracerProvider
 	serverGrpc    *grpc.Server
 	serverHttp    *http.Server
 	db            db.Database
+	hub           *pubsub.Hub
 
 	shutdown     bool
 	shutdowns    chan struct{}
@@ -79,7 +96,9 @@ func (a *Agent) setupDatabase() error {
 	if d, err := db.New(dbConfig); err != nil {
 		return err
 	} else {
-		a.db = d
+		// publish every stored reading to live subscribers
+		a.hub = pubsub.NewHub(d)
+		a.db = pubsub.NewDatabase(d, a.hub)
 	}
 
 	err := a.db.RunMigrations()
@@ -97,14 +116,26 @@ func (a *Agent) setupServers() error {
 		return err
 	} else {
 		a.traceProvider = tp
+		readingTimeWindow := server.ReadingTimeWindow{
+			MaxFuture: a.Config.ReadingMaxFuture,
+			MaxPast:   a.Config.ReadingMaxPast,
+		}
 		grpcServerConfig := &server.GrpcConfig{
-			Authorizer: authorizer,
-			Db:         a.db,
+			Authorizer:          authorizer,
+			Db:                  a.db,
+			ReadingTimeWindow:   readingTimeWindow,
+			StreamBatchSize:     a.Config.StreamBatchSize,
+			StreamFlushInterval: a.Config.StreamFlushInterval,
+			Hub:                 a.hub,
+			MaxQuerySeries:      a.Config.MaxQuerySeries,
 		}
 		httpServerConfig := &server.HttpConfig{
-			Port:            a.HttpPort,
-			MiddlewareFuncs: a.MiddlewareFuncs,
-			Db:              a.db,
+			Port:              a.HttpPort,
+			MiddlewareFuncs:   a.MiddlewareFuncs,
+			Db:                a.db,
+			ReadingTimeWindow: readingTimeWindow,
+			Hub:               a.hub,
+			MaxQuerySeries:    a.Config.MaxQuerySeries,
 		}
 		var opts []grpc.ServerOption
 		if a.Config.ServerTLSConfig != nil {
@@ -135,16 +166,34 @@ func (a *Agent) Shutdown() error {
 	a.shutdown = true
 	close(a.shutdowns)
 
+	// end the watch streams first, as the servers wait for them to finish before stopping
+	if a.hub != nil {
+		a.hub.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
 	shutdown := []func(ctx context.Context) error{
 		func(ctx context.Context) error {
-			a.serverGrpc.GracefulStop()
+			stopped := make(chan struct{})
+			go func() {
+				a.serverGrpc.GracefulStop()
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				a.serverGrpc.Stop()
+			}
 			return nil
 		},
 		a.serverHttp.Shutdown,
 		a.traceProvider.Shutdown,
 	}
 	for _, fn := range shutdown {
-		if err := fn(context.Background()); err != nil {
+		if err := fn(ctx); err != nil {
 			return err
 		}
 	}
@@ -207,5 +256,32 @@ func New(config Config) (*Agent, error) {
 			logger.Sugar().Error("error starting Grpc server", err)
 		}
 	}()
+	if a.RetentionInterval > 0 {
+		go a.applyRetention(logger.Named("retention"))
+	}
 	return a, nil
 }
+
+// applyRetention applies the retention policies every RetentionInterval until the agent shuts down.
+func (a *Agent) applyRetention(logger *zap.Logger) {
+	ticker := time.NewTicker(a.RetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.shutdowns:
+			return
+		case <-ticker.C:
+			report, err := a.db.ApplyRetention(context.Background(), a.RetentionDryRun)
+			if err != nil {
+				logger.Error("error applying retention policies", zap.Error(err))
+				continue
+			}
+			logger.Info("applied retention policies",
+				zap.Bool("dry_run", report.DryRun),
+				zap.Int64("readings_deleted", report.ReadingsDeleted),
+				zap.Strings("dropped_chunks", report.DroppedChunks),
+				zap.Any("sensors", report.Sensors))
+		}
+	}
+}
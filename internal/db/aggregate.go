@@ -0,0 +1,70 @@
+package db
+
+import (
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// aggregateSource is a relation aggregates can be computed from, along with the SQL expression of every
+// aggregate function over its rows. Rows must have time and name columns.
+type aggregateSource struct {
+	table       string
+	granularity time.Duration
+	expressions map[models.AggregateFunction]string
+}
+
+var rawReadings = aggregateSource{
+	table: "sensor_readings",
+	expressions: map[models.AggregateFunction]string{
+		models.AggregateAvg:    "avg(value)",
+		models.AggregateMin:    "min(value)",
+		models.AggregateMax:    "max(value)",
+		models.AggregateSum:    "sum(value)",
+		models.AggregateCount:  "count(value)::DOUBLE PRECISION",
+		models.AggregateFirst:  "first(value, time)",
+		models.AggregateLast:   "last(value, time)",
+		models.AggregateStddev: "stddev_samp(value)",
+	},
+}
+
+// rollupExpressions combine the partial aggregates kept by the continuous aggregates into wider buckets.
+var rollupExpressions = map[models.AggregateFunction]string{
+	models.AggregateAvg:   "(sum(value_sum) / sum(value_count))::DOUBLE PRECISION",
+	models.AggregateMin:   "min(value_min)",
+	models.AggregateMax:   "max(value_max)",
+	models.AggregateSum:   "sum(value_sum)",
+	models.AggregateCount: "sum(value_count)::DOUBLE PRECISION",
+	models.AggregateFirst: "first(first_value, first_time)",
+	models.AggregateLast:  "last(last_value, last_time)",
+	models.AggregateStddev: "CASE WHEN sum(value_count) > 1 THEN sqrt(GREATEST(sum(value_sum_squares) - " +
+		"sum(value_sum) ^ 2 / sum(value_count), 0) / (sum(value_count) - 1))::DOUBLE PRECISION END",
+}
+
+// continuousAggregates are the materialized views created by the reading_continuous_aggregates migration,
+// coarsest first.
+var continuousAggregates = []aggregateSource{
+	{table: "sensor_readings_daily", granularity: 24 * time.Hour, expressions: rollupExpressions},
+	{table: "sensor_readings_hourly", granularity: time.Hour, expressions: rollupExpressions},
+}
+
+// aggregateSourceFor picks the coarsest continuous aggregate that gives the same result as the raw readings:
+// the bucket width must be a whole number of its buckets and the range must start and end on bucket
+// boundaries. Anything finer, and any query for good readings only, is computed from sensor_readings.
+func aggregateSourceFor(query models.AggregationQuery) aggregateSource {
+	if query.GoodOnly {
+		return rawReadings
+	}
+
+	width := time.Duration(query.BucketWidth)
+
+	for _, source := range continuousAggregates {
+		if width%source.granularity == 0 &&
+			query.StartTime.Truncate(source.granularity).Equal(query.StartTime) &&
+			query.EndTime.Truncate(source.granularity).Equal(query.EndTime) {
+			return source
+		}
+	}
+
+	return rawReadings
+}
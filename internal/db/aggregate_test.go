@@ -0,0 +1,74 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+func TestAggregateSourceFor(t *testing.T) {
+	midnight := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query models.AggregationQuery
+		table string
+	}{
+		{
+			name: "daily buckets over whole days",
+			query: models.AggregationQuery{
+				StartTime: midnight, EndTime: midnight.AddDate(0, 0, 7), BucketWidth: models.Duration(24 * time.Hour),
+			},
+			table: "sensor_readings_daily",
+		},
+		{
+			name: "daily buckets starting mid-day",
+			query: models.AggregationQuery{
+				StartTime:   midnight.Add(6 * time.Hour),
+				EndTime:     midnight.AddDate(0, 0, 7),
+				BucketWidth: models.Duration(24 * time.Hour),
+			},
+			table: "sensor_readings_hourly",
+		},
+		{
+			name: "six hour buckets",
+			query: models.AggregationQuery{
+				StartTime: midnight, EndTime: midnight.AddDate(0, 0, 1), BucketWidth: models.Duration(6 * time.Hour),
+			},
+			table: "sensor_readings_hourly",
+		},
+		{
+			name: "quarter hour buckets",
+			query: models.AggregationQuery{
+				StartTime: midnight, EndTime: midnight.AddDate(0, 0, 1), BucketWidth: models.Duration(15 * time.Minute),
+			},
+			table: "sensor_readings",
+		},
+		{
+			name: "daily buckets of good readings",
+			query: models.AggregationQuery{
+				StartTime:   midnight,
+				EndTime:     midnight.AddDate(0, 0, 7),
+				BucketWidth: models.Duration(24 * time.Hour),
+				GoodOnly:    true,
+			},
+			table: "sensor_readings",
+		},
+		{
+			name: "hourly buckets ending mid-hour",
+			query: models.AggregationQuery{
+				StartTime: midnight, EndTime: midnight.Add(90 * time.Minute), BucketWidth: models.Duration(time.Hour),
+			},
+			table: "sensor_readings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.table, aggregateSourceFor(tt.query).table)
+		})
+	}
+}
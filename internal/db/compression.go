@@ -0,0 +1,268 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+func (d *Db) GetCompressionSettings(ctx context.Context) (*models.CompressionSettings, error) {
+	return compressionSettings(ctx, d.DB)
+}
+
+// SetCompression enables compression with the given compress-after policy, or decompresses every chunk and
+// disables it.
+func (d *Db) SetCompression(ctx context.Context,
+	req models.SetCompressionRequest) (*models.CompressionSettings, error) {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	current, err := compressionSettings(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `SELECT remove_compression_policy('sensor_readings', if_exists => true);`)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Enabled {
+		// compression settings cannot change while chunks are compressed, so only set them the first time
+		if !current.Enabled {
+			_, err = tx.ExecContext(ctx, `
+				ALTER TABLE sensor_readings SET (
+					timescaledb.compress,
+					timescaledb.compress_segmentby = 'name',
+					timescaledb.compress_orderby = 'time DESC'
+				);`)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `SELECT add_compression_policy('sensor_readings', $1::INTERVAL);`,
+			intervalString(time.Duration(req.CompressAfter)))
+	} else if current.Enabled {
+		if err = decompressChunks(ctx, tx, time.Time{}, time.Time{}); err != nil {
+			return nil, err
+		}
+
+		_, err = tx.ExecContext(ctx, `ALTER TABLE sensor_readings SET (timescaledb.compress = false);`)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := compressionSettings(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	d.compression.set(settings)
+
+	return settings, nil
+}
+
+// ListChunkCompression reports every chunk of the readings hypertable, oldest first, with the compression
+// ratio of the compressed ones.
+func (d *Db) ListChunkCompression(ctx context.Context) ([]*models.ChunkCompression, error) {
+	rows, err := d.QueryContext(ctx, `
+		SELECT format('%I.%I', c.chunk_schema, c.chunk_name), c.range_start, c.range_end, c.is_compressed,
+			s.before_compression_total_bytes, s.after_compression_total_bytes
+		FROM timescaledb_information.chunks c
+		LEFT JOIN chunk_compression_stats('sensor_readings') s
+			ON s.chunk_schema = c.chunk_schema AND s.chunk_name = c.chunk_name
+		WHERE c.hypertable_name = 'sensor_readings'
+		ORDER BY c.range_start;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chunks := []*models.ChunkCompression{}
+
+	for rows.Next() {
+		var (
+			chunk         models.ChunkCompression
+			before, after sql.NullInt64
+		)
+
+		err = rows.Scan(&chunk.Chunk, &chunk.RangeStart, &chunk.RangeEnd, &chunk.Compressed, &before, &after)
+		if err != nil {
+			return nil, err
+		}
+
+		if chunk.Compressed && before.Valid && after.Valid && after.Int64 > 0 {
+			chunk.BytesBeforeCompression = before.Int64
+			chunk.BytesAfterCompression = after.Int64
+			chunk.Ratio = float64(before.Int64) / float64(after.Int64)
+		}
+
+		chunks = append(chunks, &chunk)
+	}
+
+	return chunks, rows.Err()
+}
+
+func compressionSettings(ctx context.Context, q querier) (*models.CompressionSettings, error) {
+	settings := &models.CompressionSettings{SegmentBy: []string{}, OrderBy: []string{}}
+
+	var compressAfter sql.NullInt64
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT h.compression_enabled, (
+			SELECT (EXTRACT(EPOCH FROM (j.config->>'compress_after')::INTERVAL) * 1000000)::BIGINT
+			FROM timescaledb_information.jobs j
+			WHERE j.proc_name = 'policy_compression' AND j.hypertable_name = h.hypertable_name
+		)
+		FROM timescaledb_information.hypertables h
+		WHERE h.hypertable_name = 'sensor_readings';`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err = rows.Scan(&settings.Enabled, &compressAfter); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	settings.CompressAfter = models.Duration(time.Duration(compressAfter.Int64) * time.Microsecond)
+
+	columns, err := q.QueryContext(ctx, `
+		SELECT attname, segmentby_column_index, orderby_asc
+		FROM timescaledb_information.compression_settings
+		WHERE hypertable_name = 'sensor_readings'
+		ORDER BY segmentby_column_index, orderby_column_index;`)
+	if err != nil {
+		return nil, err
+	}
+	defer columns.Close()
+
+	for columns.Next() {
+		var (
+			name      string
+			segmentBy sql.NullInt64
+			ascending sql.NullBool
+		)
+
+		if err = columns.Scan(&name, &segmentBy, &ascending); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case segmentBy.Valid:
+			settings.SegmentBy = append(settings.SegmentBy, name)
+		case ascending.Valid && ascending.Bool:
+			settings.OrderBy = append(settings.OrderBy, name+" ASC")
+		case ascending.Valid:
+			settings.OrderBy = append(settings.OrderBy, name+" DESC")
+		}
+	}
+
+	return settings, columns.Err()
+}
+
+// decompressChunks decompresses the compressed chunks holding readings taken in [from, to] so that they can be
+// written to or deleted from. Zero bounds leave that side of the range open. The compression policy compresses
+// the chunks again once they are older than its compress-after interval.
+func decompressChunks(ctx context.Context, tx *sql.Tx, from, to time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+		SELECT decompress_chunk(format('%I.%I', chunk_schema, chunk_name)::REGCLASS, if_compressed => true)
+		FROM timescaledb_information.chunks
+		WHERE hypertable_name = 'sensor_readings' AND is_compressed
+			AND ($1::TIMESTAMPTZ IS NULL OR range_end > $1)
+			AND ($2::TIMESTAMPTZ IS NULL OR range_start <= $2);`,
+		sql.NullTime{Time: from, Valid: !from.IsZero()}, sql.NullTime{Time: to, Valid: !to.IsZero()})
+
+	return err
+}
+
+// compressionCacheTTL is how long writes go by the compression settings they last read. Settings changed through
+// another server are picked up once it has passed.
+const compressionCacheTTL = time.Minute
+
+// compressionCache holds the compression settings, so that writes can tell whether they might land in a
+// compressed chunk without querying the catalog every time.
+type compressionCache struct {
+	mu       sync.Mutex
+	settings *models.CompressionSettings
+	read     time.Time
+}
+
+func (c *compressionCache) get(ctx context.Context, q querier) (*models.CompressionSettings, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.settings != nil && time.Since(c.read) < compressionCacheTTL {
+		return c.settings, nil
+	}
+
+	settings, err := compressionSettings(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	c.settings, c.read = settings, time.Now()
+
+	return settings, nil
+}
+
+func (c *compressionCache) set(settings *models.CompressionSettings) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.settings, c.read = settings, time.Now()
+}
+
+// decompressChunksForWrite decompresses the compressed chunks holding readings taken in [from, to] before they
+// are written. The compression policy only compresses chunks older than its compress-after interval, so the
+// catalog is only queried when from is older than that; a zero from means there is nothing to write.
+func (d *Db) decompressChunksForWrite(ctx context.Context, tx *sql.Tx, from, to time.Time) error {
+	if from.IsZero() {
+		return nil
+	}
+
+	settings, err := d.compression.get(ctx, d.DB)
+	if err != nil {
+		return err
+	}
+
+	if !settings.Enabled || from.After(time.Now().Add(-time.Duration(settings.CompressAfter))) {
+		return nil
+	}
+
+	return decompressChunks(ctx, tx, from, to)
+}
+
+// decompressSensorChunks decompresses the compressed chunks holding readings of sensorName.
+func decompressSensorChunks(ctx context.Context, tx *sql.Tx, sensorName string) error {
+	var from, to sql.NullTime
+
+	err := tx.QueryRowContext(ctx, `
+		SELECT min(time), max(time)
+		FROM sensor_readings
+		WHERE name = $1;`, sensorName).Scan(&from, &to)
+	if err != nil || !from.Valid {
+		return err
+	}
+
+	return decompressChunks(ctx, tx, from.Time, to.Time)
+}
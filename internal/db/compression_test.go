@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+func TestDecompressChunksForWriteSkipsUncompressedTimes(t *testing.T) {
+	ctx := context.Background()
+
+	// none of these reach the database, so neither a connection nor a transaction is needed
+	d := &Db{}
+
+	d.compression.set(&models.CompressionSettings{Enabled: false})
+	require.NoError(t, d.decompressChunksForWrite(ctx, nil, time.Now().Add(-365*24*time.Hour), time.Now()))
+
+	d.compression.set(&models.CompressionSettings{Enabled: true, CompressAfter: models.Duration(7 * 24 * time.Hour)})
+	require.NoError(t, d.decompressChunksForWrite(ctx, nil, time.Now().Add(-time.Hour), time.Now()))
+
+	// nothing to write
+	require.NoError(t, d.decompressChunksForWrite(ctx, nil, time.Time{}, time.Time{}))
+}
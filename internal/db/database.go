@@ -3,7 +3,10 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/pressly/goose"
@@ -13,7 +16,31 @@ import (
 	"github.com/koneal2013/sensorsphere/internal/models"
 )
 
-const dbDriverName = "postgres"
+const (
+	dbDriverName = "postgres"
+
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+var (
+	ErrSensorNotFound    = errors.New("sensor not found")
+	ErrSensorExists      = errors.New("sensor already exists")
+	ErrSensorDeleted     = errors.New("sensor has been deleted")
+	ErrSensorHasReadings = errors.New("sensor has readings")
+	ErrInvalidDeleteMode = errors.New("invalid delete mode")
+	ErrInvalidPageToken  = errors.New("invalid page token")
+	ErrDuplicateReading  = errors.New("sensor already has a reading at this time")
+	ErrBatchAborted      = errors.New("not written because another reading in the batch was rejected")
+	ErrValueOutOfRange   = errors.New("value is outside the sensor's valid range")
+	// ErrInvalidQuality is returned for a quality that is not one of the quality codes, which the database itself
+	// does not check.
+	ErrInvalidQuality = errors.New("invalid quality")
+
+	ErrRetentionPolicyNotFound = errors.New("retention policy not found")
+	ErrTooManySeries           = errors.New("query matches too many sensors")
+	ErrTooManyReadings         = errors.New("query matches too many readings")
+)
 
 type PgConfig struct {
 	Host     string
@@ -32,16 +59,41 @@ type Database interface {
 	CreateSensor(ctx context.Context, newSensor *models.Sensor) (*models.Sensor, error)
 	GetSensor(ctx context.Context, sensorName string) (*models.Sensor, error)
 	UpdateSensor(ctx context.Context, updatedSensor *models.Sensor) (int64, error)
+	DeleteSensor(ctx context.Context, sensorName string, mode models.DeleteMode) (int64, error)
+	ListSensors(ctx context.Context, query models.ListSensorsQuery) (*models.SensorPage, error)
 	GetNearestSensor(ctx context.Context, location *models.Location) (*models.Sensor, error)
+	FindNearestSensors(ctx context.Context, query models.NearestQuery) ([]*models.SensorDistance, error)
+	FindSensorsWithinRadius(ctx context.Context, query models.RadiusQuery) (*models.SensorDistancePage, error)
+	FindSensorsInArea(ctx context.Context, query models.AreaQuery) (*models.SensorPage, error)
+	GetInterpolationSources(ctx context.Context,
+		query models.InterpolationQuery) ([]*models.InterpolationSource, error)
 	CreateSensorReading(ctx context.Context, reading *models.SensorReading) (*models.SensorReading, error)
+	CreateSensorReadings(ctx context.Context, readings []*models.SensorReading, atomic bool) ([]error, error)
+	CreateMissingSensors(ctx context.Context, names []string) ([]string, error)
+	FlagSensorReadings(ctx context.Context, req models.FlagReadingsRequest) (int64, error)
 	GetSensorReadingsForTimeRange(ctx context.Context,
-		timeRange models.TimeRangeQuery) ([]*models.SensorReading, error)
+		timeRange models.TimeRangeQuery) (*models.SensorReadingPage, error)
+	ExportSensorReadings(ctx context.Context, query models.ExportQuery, batchSize int,
+		send func([]*models.SensorReading) error) error
+	GetSensorReadingSeries(ctx context.Context, query models.SeriesQuery,
+		maxSeries, maxReadings int) ([]*models.Series, error)
+	GetLatestReadings(ctx context.Context, query models.LatestReadingsQuery) ([]*models.SensorReading, error)
+	AggregateSensorReadings(ctx context.Context, query models.AggregationQuery) ([]*models.AggregateBucket, error)
+	ResampleSensorReadings(ctx context.Context, query models.ResampleQuery) ([]*models.ResampledReading, error)
+	ListRetentionPolicies(ctx context.Context) ([]*models.RetentionPolicy, error)
+	SetRetentionPolicy(ctx context.Context, policy *models.RetentionPolicy) (*models.RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, scope models.RetentionScope, target string) error
+	ApplyRetention(ctx context.Context, dryRun bool) (*models.RetentionReport, error)
+	GetCompressionSettings(ctx context.Context) (*models.CompressionSettings, error)
+	SetCompression(ctx context.Context, req models.SetCompressionRequest) (*models.CompressionSettings, error)
+	ListChunkCompression(ctx context.Context) ([]*models.ChunkCompression, error)
 	Close() error
 	RunMigrations() error
 }
 
 type Db struct {
 	*sql.DB
+	compression compressionCache
 }
 
 func New(config PgConfig) (*Db, error) {
@@ -50,7 +102,7 @@ func New(config PgConfig) (*Db, error) {
 		return nil, err
 	}
 
-	return &Db{db}, nil
+	return &Db{DB: db}, nil
 }
 
 func (d *Db) RunMigrations() error {
@@ -71,64 +123,138 @@ func (d *Db) Close() error {
 	return d.DB.Close()
 }
 
+// CreateSensor stores newSensor. It fails with ErrSensorExists when a sensor of that name exists, or with
+// ErrSensorDeleted when the name belongs to a soft-deleted sensor, which keeps it until it is hard-deleted.
 func (d *Db) CreateSensor(ctx context.Context, newSensor *models.Sensor) (*models.Sensor, error) {
 	sqlStatement := `
-		INSERT INTO sensors (name, location, tags)
-		VALUES ($1, ST_SetSRID(ST_MakePoint($2, $3), 4326), $4);`
+		INSERT INTO sensors (name, location, tags, measurement_type, unit, min_value, max_value, range_policy)
+		VALUES ($1, ST_SetSRID(ST_MakePoint($2, $3), 4326), $4, $5, $6, $7, $8, COALESCE(NULLIF($9, ''), 'reject'));`
 
 	_, err := d.ExecContext(ctx, sqlStatement, newSensor.Name, newSensor.Location.Longitude,
-		newSensor.Location.Latitude, pq.Array(newSensor.Tags))
-	if err != nil {
+		newSensor.Location.Latitude, pq.Array(newSensor.Tags), newSensor.MeasurementType, newSensor.Unit,
+		newSensor.MinValue, newSensor.MaxValue, newSensor.RangePolicy)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+		return nil, d.existingSensorError(ctx, newSensor.Name)
+	} else if err != nil {
 		return nil, err
 	}
 
 	return newSensor, nil
 }
 
+// existingSensorError tells whether the sensor whose name a new sensor clashes with is live or soft-deleted.
+func (d *Db) existingSensorError(ctx context.Context, name string) error {
+	var deleted bool
+
+	err := d.QueryRowContext(ctx, `SELECT deleted_at IS NOT NULL FROM sensors WHERE name = $1;`, name).Scan(&deleted)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		return ErrSensorDeleted
+	}
+
+	return ErrSensorExists
+}
+
 func (d *Db) GetSensor(ctx context.Context, sensorName string) (*models.Sensor, error) {
 	sqlStatement := `
-		SELECT name, ST_AsText(location), tags
+		SELECT ` + sensorColumns + `
 		FROM sensors
-		WHERE name = $1;`
+		WHERE name = $1 AND deleted_at IS NULL;`
 
 	row := d.QueryRowContext(ctx, sqlStatement, sensorName)
 
-	var sensor models.Sensor
+	sensor, err := scanSensor(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSensorNotFound
+	} else if err != nil {
+		return nil, err
+	}
 
-	var location string
+	return sensor, nil
+}
 
-	err := row.Scan(&sensor.Name, &location, pq.Array(&sensor.Tags))
-	if err != nil {
+// ListSensors returns one page of sensors matching query, ordered by name.
+func (d *Db) ListSensors(ctx context.Context, query models.ListSensorsQuery) (*models.SensorPage, error) {
+	var cursor sensorCursor
+	if err := decodePageToken(query.PageToken, &cursor); err != nil {
 		return nil, err
 	}
 
-	// Parse location
-	geometry, err := wkt.Unmarshal(location)
+	pageSize := pageSizeOrDefault(query.PageSize)
+
+	var args queryArgs
+
+	conditions := []string{"deleted_at IS NULL"}
+	if query.NamePrefix != "" {
+		conditions = append(conditions, "name LIKE "+args.add(likePrefix(query.NamePrefix)))
+	}
+
+	conditions = append(conditions, tagConditions(&args, query.AnyTags, query.AllTags)...)
+
+	comparison, direction := ">", "ASC"
+	if query.Order == models.SortOrderDesc {
+		comparison, direction = "<", "DESC"
+	}
+
+	if cursor.Name != "" {
+		conditions = append(conditions, "name "+comparison+" "+args.add(cursor.Name))
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT `+sensorColumns+`
+		FROM sensors
+		WHERE %s
+		ORDER BY name %s
+		LIMIT %d;`, strings.Join(conditions, " AND "), direction, pageSize+1)
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	point, ok := geometry.(*geom.Point)
-	if !ok {
-		return nil, fmt.Errorf("location is not a point")
+	page := &models.SensorPage{Sensors: []*models.Sensor{}}
+
+	for rows.Next() {
+		sensor, err := scanSensor(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Sensors = append(page.Sensors, sensor)
 	}
 
-	sensor.Location = models.Location{
-		Longitude: point.X(),
-		Latitude:  point.Y(),
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return &sensor, nil
+	// the extra row only tells us there is another page
+	if len(page.Sensors) > pageSize {
+		page.Sensors = page.Sensors[:pageSize]
+		page.NextPageToken, err = encodePageToken(sensorCursor{Name: page.Sensors[pageSize-1].Name})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
 }
 
 func (d *Db) UpdateSensor(ctx context.Context, updatedSensor *models.Sensor) (int64, error) {
 	sqlStatement := `
 		UPDATE sensors
-		SET  location = ST_SetSRID(ST_MakePoint($2, $3), 4326), tags = $4
-		WHERE name = $1;`
+		SET  location = ST_SetSRID(ST_MakePoint($2, $3), 4326), tags = $4, measurement_type = $5, unit = $6,
+			min_value = $7, max_value = $8, range_policy = COALESCE(NULLIF($9, ''), 'reject')
+		WHERE name = $1 AND deleted_at IS NULL;`
 
 	res, err := d.ExecContext(ctx, sqlStatement, updatedSensor.Name, updatedSensor.Location.Longitude,
-		updatedSensor.Location.Latitude, pq.Array(updatedSensor.Tags))
+		updatedSensor.Location.Latitude, pq.Array(updatedSensor.Tags), updatedSensor.MeasurementType,
+		updatedSensor.Unit, updatedSensor.MinValue, updatedSensor.MaxValue, updatedSensor.RangePolicy)
 	if err != nil {
 		return 0, err
 	}
@@ -141,84 +267,1028 @@ func (d *Db) UpdateSensor(ctx context.Context, updatedSensor *models.Sensor) (in
 	return rowsAffected, err
 }
 
-func (d *Db) GetNearestSensor(ctx context.Context, location *models.Location) (*models.Sensor, error) {
-	sqlStatement := `
-		SELECT name, ST_AsText(location), tags
+// DeleteSensor removes a sensor according to mode and returns the number of readings deleted with it.
+func (d *Db) DeleteSensor(ctx context.Context, sensorName string, mode models.DeleteMode) (int64, error) {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// lock the sensor row so no readings can be attached to it while it is being removed
+	err = tx.QueryRowContext(ctx, `
+		SELECT name
 		FROM sensors
-		ORDER BY location <-> ST_SetSRID(ST_MakePoint($1, $2), 4326)
-		LIMIT 1;`
+		WHERE name = $1 AND deleted_at IS NULL
+		FOR UPDATE;`, sensorName).Scan(&sensorName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrSensorNotFound
+	} else if err != nil {
+		return 0, err
+	}
 
-	row := d.QueryRowContext(ctx, sqlStatement, location.Longitude, location.Latitude)
+	var readingsDeleted int64
 
-	var sensor models.Sensor
+	switch mode {
+	case models.DeleteModeRestrict:
+		var hasReadings bool
 
-	var loc string
+		err = tx.QueryRowContext(ctx, `
+			SELECT EXISTS (SELECT 1 FROM sensor_readings WHERE name = $1);`, sensorName).Scan(&hasReadings)
+		if err != nil {
+			return 0, err
+		}
 
-	err := row.Scan(&sensor.Name, &loc, pq.Array(&sensor.Tags))
+		if hasReadings {
+			return 0, ErrSensorHasReadings
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM sensors WHERE name = $1;`, sensorName)
+	case models.DeleteModeCascade:
+		var res sql.Result
+
+		if err = decompressSensorChunks(ctx, tx, sensorName); err != nil {
+			return 0, err
+		}
+
+		res, err = tx.ExecContext(ctx, `DELETE FROM sensor_readings WHERE name = $1;`, sensorName)
+		if err != nil {
+			return 0, err
+		}
+
+		readingsDeleted, err = res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM sensors WHERE name = $1;`, sensorName)
+	case models.DeleteModeSoft:
+		_, err = tx.ExecContext(ctx, `UPDATE sensors SET deleted_at = NOW() WHERE name = $1;`, sensorName)
+	default:
+		return 0, ErrInvalidDeleteMode
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return readingsDeleted, tx.Commit()
+}
+
+// GetNearestSensor returns the sensor nearest location, or ErrSensorNotFound when no sensor has a location.
+func (d *Db) GetNearestSensor(ctx context.Context, location *models.Location) (*models.Sensor, error) {
+	sensors, err := d.FindNearestSensors(ctx, models.NearestQuery{
+		Longitude: location.Longitude,
+		Latitude:  location.Latitude,
+		K:         1,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse location
-	geometry, err := wkt.Unmarshal(loc)
+	if len(sensors) == 0 {
+		return nil, ErrSensorNotFound
+	}
+
+	return &sensors[0].Sensor, nil
+}
+
+// GetSensorReadingsForTimeRange returns one page of the sensor's readings taken in the time range, ordered by
+// time and then name. Pages are keyed on the (time, name) of the last reading, so they stay consistent while
+// readings are written and cost the same however deep into the range they are. Soft-deleted sensors have no
+// readings.
+func (d *Db) GetSensorReadingsForTimeRange(ctx context.Context,
+	timeRange models.TimeRangeQuery) (*models.SensorReadingPage, error) {
+	var cursor readingCursor
+	if err := decodePageToken(timeRange.PageToken, &cursor); err != nil {
+		return nil, err
+	}
+
+	pageSize := readingPageSizeOrDefault(timeRange.PageSize)
+
+	args := queryArgs{timeRange.SensorName, timeRange.StartTime, timeRange.EndTime}
+	conditions := []string{"r.name = $1", "r.time BETWEEN $2 AND $3", "s.deleted_at IS NULL"}
+
+	comparison, direction := ">", "ASC"
+	if timeRange.Order == models.SortOrderDesc {
+		comparison, direction = "<", "DESC"
+	}
+
+	if timeRange.GoodOnly {
+		conditions = append(conditions, "r.quality = 'good'")
+	}
+
+	if cursor.Name != "" {
+		conditions = append(conditions,
+			"(r.time, r.name) "+comparison+" ("+args.add(cursor.Time)+", "+args.add(cursor.Name)+")")
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT `+readingColumns+`
+		FROM sensor_readings r
+		JOIN sensors s ON s.name = r.name
+		WHERE %s
+		ORDER BY r.time %[2]s, r.name %[2]s
+		LIMIT %d;`, strings.Join(conditions, " AND "), direction, pageSize+1)
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	point, ok := geometry.(*geom.Point)
-	if !ok {
-		return nil, fmt.Errorf("location is not a point")
+	page := &models.SensorReadingPage{SensorReadings: []*models.SensorReading{}}
+
+	for rows.Next() {
+		sensorReading, err := scanReading(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		page.SensorReadings = append(page.SensorReadings, sensorReading)
 	}
 
-	sensor.Location = models.Location{
-		Longitude: point.X(),
-		Latitude:  point.Y(),
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return &sensor, nil
+	// the extra row only tells us there is another page
+	if len(page.SensorReadings) > pageSize {
+		page.SensorReadings = page.SensorReadings[:pageSize]
+		last := page.SensorReadings[pageSize-1]
+
+		page.NextPageToken, err = encodePageToken(readingCursor{Time: last.Time, Name: last.SensorName})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
 }
 
-func (d *Db) GetSensorReadingsForTimeRange(ctx context.Context,
-	timeRange models.TimeRangeQuery) ([]*models.SensorReading, error) {
-	sqlStatement := `
+// ExportSensorReadings reads the readings selected by query through a server-side cursor, ordered by time and
+// then name, and hands them to send in batches of batchSize readings as they are fetched, so the selection is
+// never held in memory at once. Returning an error from send stops the export.
+func (d *Db) ExportSensorReadings(ctx context.Context, query models.ExportQuery, batchSize int,
+	send func([]*models.SensorReading) error,
+) error {
+	tx, err := d.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	args := queryArgs{query.StartTime, query.EndTime}
+
+	sensorConditions := append([]string{"deleted_at IS NULL"}, tagConditions(&args, query.AnyTags, query.AllTags)...)
+	if len(query.SensorNames) > 0 {
+		sensorConditions = append(sensorConditions, "name = ANY("+args.add(pq.Array(query.SensorNames))+")")
+	}
+
+	direction := "ASC"
+	if query.Order == models.SortOrderDesc {
+		direction = "DESC"
+	}
+
+	readingConditions := ""
+	if query.GoodOnly {
+		readingConditions += " AND r.quality = 'good'"
+	}
+
+	if query.AfterSensorName != "" {
+		readingConditions += " AND (r.time, r.name) > ($1, " + args.add(query.AfterSensorName) + ")"
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+		DECLARE reading_export NO SCROLL CURSOR FOR
+		SELECT `+readingColumns+`
+		FROM sensor_readings r
+		JOIN sensors s ON s.name = r.name
+		WHERE r.time BETWEEN $1 AND $2%[3]s AND r.name IN (
+			SELECT name
+			FROM sensors
+			WHERE %[1]s
+		)
+		ORDER BY r.time %[2]s, r.name %[2]s;`, strings.Join(sensorConditions, " AND "), direction, readingConditions),
+		args...)
+	if err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf(`FETCH FORWARD %d FROM reading_export;`, readingPageSizeOrDefault(batchSize))
+
+	for {
+		batch, err := fetchSensorReadings(ctx, tx, fetch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) == 0 {
+			return tx.Commit()
+		}
+
+		if err = send(batch); err != nil {
+			return err
+		}
+	}
+}
+
+func fetchSensorReadings(ctx context.Context, q querier, fetch string) ([]*models.SensorReading, error) {
+	rows, err := q.QueryContext(ctx, fetch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var readings []*models.SensorReading
+
+	for rows.Next() {
+		sensorReading, err := scanReading(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		readings = append(readings, sensorReading)
+	}
+
+	return readings, rows.Err()
+}
+
+// GetSensorReadingSeries returns one series per selected sensor, ordered by name, with the sensor's readings in
+// the time range in time order. It fails with ErrTooManySeries when more than maxSeries sensors are selected and
+// with ErrTooManyReadings when the series would hold more than maxReadings readings altogether.
+func (d *Db) GetSensorReadingSeries(ctx context.Context, query models.SeriesQuery,
+	maxSeries, maxReadings int,
+) ([]*models.Series, error) {
+	var args queryArgs
+
+	conditions := append([]string{"deleted_at IS NULL"}, tagConditions(&args, query.AnyTags, query.AllTags)...)
+	if len(query.SensorNames) > 0 {
+		conditions = append(conditions, "name = ANY("+args.add(pq.Array(query.SensorNames))+")")
+	}
+
+	rows, err := d.QueryContext(ctx, fmt.Sprintf(`
+		SELECT name, unit
+		FROM sensors
+		WHERE %s
+		ORDER BY name
+		LIMIT %d;`, strings.Join(conditions, " AND "), maxSeries+1), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series := []*models.Series{}
+	seriesByName := map[string]*models.Series{}
+	names := []string{}
+
+	for rows.Next() {
+		s := &models.Series{Points: []models.SeriesPoint{}}
+		if err = rows.Scan(&s.SensorName, &s.Unit); err != nil {
+			return nil, err
+		}
+
+		series = append(series, s)
+		seriesByName[s.SensorName] = s
+		names = append(names, s.SensorName)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(series) > maxSeries {
+		return nil, fmt.Errorf("%w: the limit is %d", ErrTooManySeries, maxSeries)
+	}
+
+	if len(series) == 0 {
+		return series, nil
+	}
+
+	quality := ""
+	if query.GoodOnly {
+		quality = "AND quality = 'good'"
+	}
+
+	readings, err := d.QueryContext(ctx, fmt.Sprintf(`
 		SELECT name, value, time
 		FROM sensor_readings
-		WHERE name = $1 AND time BETWEEN $2 AND $3;`
+		WHERE name = ANY($1) AND time BETWEEN $2 AND $3 %s
+		ORDER BY name, time
+		LIMIT $4;`, quality), pq.Array(names), query.StartTime, query.EndTime, maxReadings+1)
+	if err != nil {
+		return nil, err
+	}
+	defer readings.Close()
+
+	count := 0
+
+	for readings.Next() {
+		var (
+			name  string
+			point models.SeriesPoint
+		)
+
+		if err = readings.Scan(&name, &point.Value, &point.Time); err != nil {
+			return nil, err
+		}
+
+		if count++; count > maxReadings {
+			return nil, fmt.Errorf("%w: the limit is %d, narrow the time range", ErrTooManyReadings, maxReadings)
+		}
+
+		seriesByName[name].Points = append(seriesByName[name].Points, point)
+	}
+
+	return series, readings.Err()
+}
 
-	rows, err := d.QueryContext(ctx, sqlStatement, timeRange.SensorName, timeRange.StartTime, timeRange.EndTime)
+// GetLatestReadings returns the most recent reading of every selected sensor that has one, ordered by sensor
+// name. Readings come from sensor_latest_readings rather than the hypertable.
+func (d *Db) GetLatestReadings(ctx context.Context,
+	query models.LatestReadingsQuery) ([]*models.SensorReading, error) {
+	var args queryArgs
+
+	conditions := append([]string{"s.deleted_at IS NULL"}, tagConditions(&args, nil, query.Tags)...)
+	if len(query.SensorNames) > 0 {
+		conditions = append(conditions, "l.name = ANY("+args.add(pq.Array(query.SensorNames))+")")
+	}
+
+	// sensor_latest_readings only knows the latest reading of each sensor, whatever its quality
+	latest := "sensor_latest_readings l"
+	if query.GoodOnly {
+		latest = `sensor_latest_readings m CROSS JOIN LATERAL (
+			SELECT name, value, time, out_of_range, quality, annotation
+			FROM sensor_readings
+			WHERE name = m.name AND quality = 'good'
+			ORDER BY time DESC
+			LIMIT 1
+		) l`
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT l.name, l.value, l.time, l.out_of_range, l.quality, l.annotation, s.unit
+		FROM %s
+		JOIN sensors s ON s.name = l.name
+		WHERE %s
+		ORDER BY l.name;`, latest, strings.Join(conditions, " AND "))
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	sensorReadings := []*models.SensorReading{}
+	readings := []*models.SensorReading{}
 
 	for rows.Next() {
-		var sensorReading models.SensorReading
-
-		err = rows.Scan(&sensorReading.SensorName, &sensorReading.Value, &sensorReading.Time)
+		reading, err := scanReading(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		sensorReadings = append(sensorReadings, &sensorReading)
+		readings = append(readings, reading)
 	}
 
-	return sensorReadings, nil
+	return readings, rows.Err()
 }
 
+// ResampleSensorReadings returns one point per query.Interval in [StartTime, EndTime) using time_bucket_gapfill.
+// Points without readings are filled according to query.Fill; locf and linear fill look at the readings just
+// outside the range, so the first and last points can be filled too. It fails with ErrSensorNotFound when the
+// sensor does not exist or has been soft-deleted.
+func (d *Db) ResampleSensorReadings(ctx context.Context,
+	query models.ResampleQuery) ([]*models.ResampledReading, error) {
+	var exists bool
+
+	err := d.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM sensors WHERE name = $1 AND deleted_at IS NULL);`, query.SensorName).Scan(&exists)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, ErrSensorNotFound
+	}
+
+	var args queryArgs
+
+	interval := args.add(intervalString(time.Duration(query.Interval)))
+	start := args.add(query.StartTime)
+	end := args.add(query.EndTime)
+	name := args.add(query.SensorName)
+
+	quality := ""
+	if query.GoodOnly {
+		quality = " AND quality = 'good'"
+	}
+
+	previous := fmt.Sprintf(`
+		SELECT %%s FROM sensor_readings WHERE name = %s AND time < %s%s ORDER BY time DESC LIMIT 1`, name, start, quality)
+	next := fmt.Sprintf(`
+		SELECT %%s FROM sensor_readings WHERE name = %s AND time >= %s%s ORDER BY time LIMIT 1`, name, end, quality)
+
+	var value string
+
+	switch query.Fill {
+	case models.FillNull, "":
+		value = "avg(value)"
+	case models.FillLOCF:
+		value = fmt.Sprintf("locf(avg(value), prev => (%s))", fmt.Sprintf(previous, "value"))
+	case models.FillLinear:
+		value = fmt.Sprintf("interpolate(avg(value), prev => (%s), next => (%s))",
+			fmt.Sprintf(previous, "(time, value)"), fmt.Sprintf(next, "(time, value)"))
+	case models.FillConstant:
+		if query.FillValue == nil {
+			return nil, fmt.Errorf("constant fill needs a fill value")
+		}
+
+		value = "COALESCE(avg(value), " + args.add(*query.FillValue) + ")"
+	default:
+		return nil, fmt.Errorf("unknown fill strategy %q", query.Fill)
+	}
+
+	// the sensor is checked again in case it is deleted in the meantime
+	sqlStatement := fmt.Sprintf(`
+		SELECT time_bucket_gapfill(%[1]s::INTERVAL, time, %[2]s, %[3]s) AS bucket, %[4]s, avg(value) IS NULL
+		FROM sensor_readings
+		WHERE name IN (SELECT name FROM sensors WHERE name = %[5]s AND deleted_at IS NULL)
+			AND time >= %[2]s AND time < %[3]s%[6]s
+		GROUP BY bucket
+		ORDER BY bucket;`, interval, start, end, value, name, quality)
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	readings := []*models.ResampledReading{}
+
+	for rows.Next() {
+		reading := &models.ResampledReading{SensorName: query.SensorName}
+
+		var value sql.NullFloat64
+
+		if err = rows.Scan(&reading.Time, &value, &reading.Synthesized); err != nil {
+			return nil, err
+		}
+
+		if value.Valid {
+			reading.Value = &value.Float64
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings, rows.Err()
+}
+
+// AggregateSensorReadings returns one row of aggregates per time_bucket of query.BucketWidth in
+// [StartTime, EndTime) over the readings of the sensor named and/or tagged by query, leaving out soft-deleted
+// sensors. Buckets without readings are left out. Wide, aligned buckets are rolled up from the hourly or daily
+// continuous aggregates instead of scanning every reading.
+func (d *Db) AggregateSensorReadings(ctx context.Context,
+	query models.AggregationQuery) ([]*models.AggregateBucket, error) {
+	var args queryArgs
+
+	source := aggregateSourceFor(query)
+	bucketWidth := args.add(intervalString(time.Duration(query.BucketWidth)))
+
+	columns := make([]string, len(query.Functions))
+	for i, function := range query.Functions {
+		expression, ok := source.expressions[function]
+		if !ok {
+			return nil, fmt.Errorf("unknown aggregate function %q", function)
+		}
+
+		columns[i] = expression
+	}
+
+	conditions := []string{
+		"time >= " + args.add(query.StartTime),
+		"time < " + args.add(query.EndTime),
+	}
+
+	if query.GoodOnly {
+		conditions = append(conditions, "quality = 'good'")
+	}
+
+	sensorConditions := append([]string{"deleted_at IS NULL"}, tagConditions(&args, nil, query.Tags)...)
+	if query.SensorName != "" {
+		sensorConditions = append(sensorConditions, "name = "+args.add(query.SensorName))
+	}
+
+	conditions = append(conditions, "name IN (SELECT name FROM sensors WHERE "+
+		strings.Join(sensorConditions, " AND ")+")")
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT time_bucket(%s::INTERVAL, time) AS bucket, %s
+		FROM %s
+		WHERE %s
+		GROUP BY bucket
+		ORDER BY bucket;`, bucketWidth, strings.Join(columns, ", "), source.table, strings.Join(conditions, " AND "))
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := []*models.AggregateBucket{}
+
+	for rows.Next() {
+		bucket := &models.AggregateBucket{Values: make(map[models.AggregateFunction]float64, len(columns))}
+		values := make([]sql.NullFloat64, len(columns))
+
+		dest := []interface{}{&bucket.Time}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		for i, value := range values {
+			if value.Valid {
+				bucket.Values[query.Functions[i]] = value.Float64
+			}
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+// CreateSensorReading stores reading at reading.Time, or at the database's current time when it is unset. It
+// fails with ErrSensorNotFound when the sensor does not exist or has been soft-deleted.
 func (d *Db) CreateSensorReading(ctx context.Context, reading *models.SensorReading) (*models.SensorReading, error) {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	limits, err := sensorLimits(ctx, tx, []string{reading.SensorName})
+	if err != nil {
+		return nil, err
+	}
+
+	sensor, ok := limits[reading.SensorName]
+	if !ok {
+		return nil, ErrSensorNotFound
+	}
+
+	if err = checkRange(sensor, reading); err != nil {
+		return nil, err
+	}
+
+	if err = defaultQuality(reading); err != nil {
+		return nil, err
+	}
+
+	// a reading without a time is stored at NOW(), which is never in a compressed chunk
+	if err = d.decompressChunksForWrite(ctx, tx, reading.Time, reading.Time); err != nil {
+		return nil, err
+	}
+
 	sqlStatement := `
-		INSERT INTO sensor_readings (name, value, time)
-		VALUES ($1, $2, NOW())
+		INSERT INTO sensor_readings (name, value, time, out_of_range, quality, annotation)
+		VALUES ($1, $2, COALESCE($3, NOW()), $4, $5, $6)
 		RETURNING time;`
 
-	row := d.QueryRowContext(ctx, sqlStatement, reading.SensorName, reading.Value)
+	readingTime := sql.NullTime{Time: reading.Time, Valid: !reading.Time.IsZero()}
+	row := tx.QueryRowContext(ctx, sqlStatement, reading.SensorName, reading.Value, readingTime, reading.OutOfRange,
+		reading.Quality, reading.Annotation)
+
+	err = row.Scan(&reading.Time)
+	if err != nil {
+		return nil, readingInsertError(err)
+	}
+
+	return reading, tx.Commit()
+}
+
+// CreateSensorReadings writes readings through COPY. The returned slice holds one entry per reading: nil when
+// it was written (with its Time filled in), otherwise the reason it was not; readings for sensors that do not
+// exist or have been soft-deleted fail with ErrSensorNotFound. When atomic is set either every reading is written
+// or none is.
+func (d *Db) CreateSensorReadings(ctx context.Context, readings []*models.SensorReading, atomic bool) ([]error, error) {
+	itemErrs := make([]error, len(readings))
+	if len(readings) == 0 {
+		return itemErrs, nil
+	}
+
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var now time.Time
+	if err = tx.QueryRowContext(ctx, `SELECT NOW();`).Scan(&now); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(readings))
+	for _, reading := range readings {
+		names = append(names, reading.SensorName)
+	}
+
+	limits, err := sensorLimits(ctx, tx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[readingKey]int, len(readings))
+
+	for i, reading := range readings {
+		if reading.Time.IsZero() {
+			reading.Time = now
+		}
+		// postgres keeps microseconds, so match on what will come back from RETURNING
+		reading.Time = reading.Time.Truncate(time.Microsecond)
+
+		key := newReadingKey(reading.SensorName, reading.Time)
+
+		sensor, known := limits[reading.SensorName]
+
+		switch _, duplicate := pending[key]; {
+		case !known:
+			itemErrs[i] = ErrSensorNotFound
+		case duplicate:
+			itemErrs[i] = ErrDuplicateReading
+		default:
+			if itemErrs[i] = checkRange(sensor, reading); itemErrs[i] == nil {
+				if itemErrs[i] = defaultQuality(reading); itemErrs[i] == nil {
+					pending[key] = i
+				}
+			}
+		}
+	}
+
+	if atomic && len(pending) < len(readings) {
+		return abortBatch(itemErrs), nil
+	}
+
+	from, to := pendingTimeRange(readings, pending)
+	if err = d.decompressChunksForWrite(ctx, tx, from, to); err != nil {
+		return nil, err
+	}
+
+	if err = copyToStaging(ctx, tx, readings, pending); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		INSERT INTO sensor_readings (name, value, time, out_of_range, quality, annotation)
+		SELECT name, value, time, out_of_range, quality, annotation
+		FROM sensor_readings_staging
+		ON CONFLICT (time, name) DO NOTHING
+		RETURNING name, time;`)
+	if err != nil {
+		return nil, readingInsertError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+
+		var readingTime time.Time
+
+		if err = rows.Scan(&name, &readingTime); err != nil {
+			return nil, err
+		}
+
+		delete(pending, newReadingKey(name, readingTime))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// whatever was not returned collided with a reading already stored
+	for _, i := range pending {
+		itemErrs[i] = ErrDuplicateReading
+	}
+
+	if atomic && len(pending) > 0 {
+		return abortBatch(itemErrs), nil
+	}
+
+	return itemErrs, tx.Commit()
+}
+
+// CreateMissingSensors creates a sensor without a location or tags for each of names that does not exist yet,
+// and returns the names of the sensors it created. Soft-deleted sensors count as existing and are not restored,
+// so readings for them are still refused.
+func (d *Db) CreateMissingSensors(ctx context.Context, names []string) ([]string, error) {
+	rows, err := d.QueryContext(ctx, `
+		WITH created AS (
+			INSERT INTO sensors (name, tags)
+			SELECT DISTINCT unnest($1::TEXT[]), '{}'::TEXT[]
+			ON CONFLICT (name) DO NOTHING
+			RETURNING name
+		)
+		SELECT name FROM created ORDER BY name;`, pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	created := []string{}
+
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		created = append(created, name)
+	}
+
+	return created, rows.Err()
+}
+
+// FlagSensorReadings sets the quality and annotation of a sensor's readings in the request's time range and
+// returns how many readings were updated.
+func (d *Db) FlagSensorReadings(ctx context.Context, req models.FlagReadingsRequest) (int64, error) {
+	if !req.Quality.Valid() {
+		return 0, fmt.Errorf("%w %q", ErrInvalidQuality, req.Quality)
+	}
+
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var exists bool
 
-	err := row.Scan(&reading.Time)
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM sensors WHERE name = $1 AND deleted_at IS NULL);`, req.SensorName).Scan(&exists)
+	if err != nil {
+		return 0, err
+	} else if !exists {
+		return 0, ErrSensorNotFound
+	}
+
+	if err = decompressChunks(ctx, tx, req.StartTime, req.EndTime); err != nil {
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE sensor_readings
+		SET quality = $4, annotation = $5
+		WHERE name = $1 AND time BETWEEN $2 AND $3;`,
+		req.SensorName, req.StartTime, req.EndTime, req.Quality, req.Annotation)
+	if err != nil {
+		return 0, err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	// the trigger only sees inserts, so keep the latest reading in step
+	_, err = tx.ExecContext(ctx, `
+		UPDATE sensor_latest_readings
+		SET quality = $4, annotation = $5
+		WHERE name = $1 AND time BETWEEN $2 AND $3;`,
+		req.SensorName, req.StartTime, req.EndTime, req.Quality, req.Annotation)
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, tx.Commit()
+}
+
+type readingKey struct {
+	name string
+	time int64
+}
+
+func newReadingKey(name string, t time.Time) readingKey {
+	return readingKey{name: name, time: t.UnixMicro()}
+}
+
+// pendingTimeRange returns the earliest and latest time of the readings at the indexes in pending.
+func pendingTimeRange(readings []*models.SensorReading, pending map[readingKey]int) (from, to time.Time) {
+	for _, i := range pending {
+		t := readings[i].Time
+		if from.IsZero() || t.Before(from) {
+			from = t
+		}
+
+		if to.IsZero() || t.After(to) {
+			to = t
+		}
+	}
+
+	return from, to
+}
+
+// abortBatch marks every reading that was not rejected on its own as aborted.
+func abortBatch(itemErrs []error) []error {
+	for i, err := range itemErrs {
+		if err == nil {
+			itemErrs[i] = ErrBatchAborted
+		}
+	}
+
+	return itemErrs
+}
+
+// sensorLimits returns the unit and valid range of those of names that belong to a sensor, keyed by name.
+// Soft-deleted sensors are left out, so readings cannot be written for them.
+func sensorLimits(ctx context.Context, tx *sql.Tx, names []string) (map[string]*models.Sensor, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT name, unit, min_value, max_value, range_policy
+		FROM sensors
+		WHERE name = ANY($1) AND deleted_at IS NULL;`, pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	limits := make(map[string]*models.Sensor, len(names))
+
+	for rows.Next() {
+		var (
+			sensor             models.Sensor
+			minValue, maxValue sql.NullFloat64
+		)
+
+		if err = rows.Scan(&sensor.Name, &sensor.Unit, &minValue, &maxValue, &sensor.RangePolicy); err != nil {
+			return nil, err
+		}
+
+		if minValue.Valid {
+			sensor.MinValue = &minValue.Float64
+		}
+
+		if maxValue.Valid {
+			sensor.MaxValue = &maxValue.Float64
+		}
+
+		limits[sensor.Name] = &sensor
+	}
+
+	return limits, rows.Err()
+}
+
+// checkRange stamps reading with its sensor's unit and holds it against the sensor's valid range: a reading
+// outside the range fails with ErrValueOutOfRange, or is flagged as out of range when the sensor's policy says so.
+func checkRange(sensor *models.Sensor, reading *models.SensorReading) error {
+	reading.Unit = sensor.Unit
+	reading.OutOfRange = !sensor.InRange(reading.Value)
+
+	if reading.OutOfRange && sensor.RangePolicy != models.RangePolicyFlag {
+		return ErrValueOutOfRange
+	}
+
+	return nil
+}
+
+// defaultQuality marks a reading written without a quality as good, or as suspect when it is out of range, and
+// fails with ErrInvalidQuality when its quality is not a quality code.
+func defaultQuality(reading *models.SensorReading) error {
+	switch {
+	case reading.Quality != "":
+		if !reading.Quality.Valid() {
+			return fmt.Errorf("%w %q", ErrInvalidQuality, reading.Quality)
+		}
+	case reading.OutOfRange:
+		reading.Quality = models.QualitySuspect
+	default:
+		reading.Quality = models.QualityGood
+	}
+
+	return nil
+}
+
+// copyToStaging loads the readings at the indexes in pending into a transaction scoped staging table.
+func copyToStaging(ctx context.Context, tx *sql.Tx,
+	readings []*models.SensorReading, pending map[readingKey]int,
+) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TEMPORARY TABLE sensor_readings_staging (
+			name TEXT NOT NULL,
+			value DOUBLE PRECISION NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			out_of_range BOOLEAN NOT NULL,
+			quality TEXT NOT NULL,
+			annotation TEXT NOT NULL
+		) ON COMMIT DROP;`)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("sensor_readings_staging",
+		"name", "value", "time", "out_of_range", "quality", "annotation"))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, i := range pending {
+		reading := readings[i]
+		if _, err = stmt.ExecContext(ctx, reading.SensorName, reading.Value, reading.Time,
+			reading.OutOfRange, reading.Quality, reading.Annotation); err != nil {
+			return err
+		}
+	}
+
+	// an argument-less Exec flushes the COPY buffer
+	if _, err = stmt.ExecContext(ctx); err != nil {
+		return err
+	}
+
+	return stmt.Close()
+}
+
+// readingInsertError maps constraint violations raised by inserting readings onto the package's errors.
+func readingInsertError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case pgUniqueViolation:
+			return ErrDuplicateReading
+		case pgForeignKeyViolation:
+			return ErrSensorNotFound
+		}
+	}
+
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// readingColumns are the columns scanReading expects, in order, from sensor_readings r joined with sensors s.
+const readingColumns = "r.name, r.value, r.time, r.out_of_range, r.quality, r.annotation, s.unit"
+
+// scanReading scans a row of readingColumns.
+func scanReading(row rowScanner) (*models.SensorReading, error) {
+	var reading models.SensorReading
+
+	err := row.Scan(&reading.SensorName, &reading.Value, &reading.Time, &reading.OutOfRange, &reading.Quality,
+		&reading.Annotation, &reading.Unit)
 	if err != nil {
 		return nil, err
 	}
 
-	return reading, nil
+	return &reading, nil
+}
+
+// sensorColumns are the columns scanSensor expects, in order.
+const sensorColumns = "name, ST_AsText(location), tags, measurement_type, unit, min_value, max_value, range_policy"
+
+// scanSensor scans a row of sensorColumns, followed by any extra columns in dest.
+func scanSensor(row rowScanner, dest ...interface{}) (*models.Sensor, error) {
+	var sensor models.Sensor
+
+	var (
+		location           sql.NullString
+		minValue, maxValue sql.NullFloat64
+	)
+
+	err := row.Scan(append([]interface{}{&sensor.Name, &location, pq.Array(&sensor.Tags), &sensor.MeasurementType,
+		&sensor.Unit, &minValue, &maxValue, &sensor.RangePolicy}, dest...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if minValue.Valid {
+		sensor.MinValue = &minValue.Float64
+	}
+
+	if maxValue.Valid {
+		sensor.MaxValue = &maxValue.Float64
+	}
+
+	// sensors created by an import have no location until one is set
+	if !location.Valid {
+		return &sensor, nil
+	}
+
+	// Parse location
+	geometry, err := wkt.Unmarshal(location.String)
+	if err != nil {
+		return nil, err
+	}
+
+	point, ok := geometry.(*geom.Point)
+	if !ok {
+		return nil, fmt.Errorf("location is not a point")
+	}
+
+	sensor.Location = models.Location{
+		Longitude: point.X(),
+		Latitude:  point.Y(),
+	}
+
+	return &sensor, nil
 }
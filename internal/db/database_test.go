@@ -0,0 +1,221 @@
+package db
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose"
+	"github.com/stretchr/testify/require"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// newTestDb connects to the PostgreSQL on SENSORSPHERE_TEST_DB_HOST with the credentials in config.json and
+// migrates it, or skips the test when the variable is unset. `make test` starts that database with docker-compose.
+func newTestDb(t *testing.T) *Db {
+	t.Helper()
+
+	host := os.Getenv("SENSORSPHERE_TEST_DB_HOST")
+	if host == "" {
+		t.Skip("SENSORSPHERE_TEST_DB_HOST is not set")
+	}
+
+	d, err := New(PgConfig{Host: host, Port: 5432, User: "postgres", Password: "mysecretpassword",
+		Dbname: "sensor_sphere"})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { d.Close() })
+
+	require.NoError(t, goose.SetDialect(dbDriverName))
+	require.NoError(t, goose.Up(d.DB, "migrations"))
+
+	return d
+}
+
+// createTestSensor creates a sensor named after the test and removes it and its readings when the test ends.
+func createTestSensor(t *testing.T, d *Db) string {
+	t.Helper()
+
+	name := t.Name() + "-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	_, err := d.CreateSensor(context.Background(), &models.Sensor{
+		Name:     name,
+		Location: models.Location{Longitude: 1, Latitude: 1},
+		Tags:     []string{"test"},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		d.ExecContext(context.Background(), `DELETE FROM sensor_readings WHERE name = $1;`, name)
+		d.ExecContext(context.Background(), `DELETE FROM sensors WHERE name = $1;`, name)
+	})
+
+	return name
+}
+
+func TestCreateSensorReadingSoftDeletedSensor(t *testing.T) {
+	d := newTestDb(t)
+	ctx := context.Background()
+	name := createTestSensor(t, d)
+
+	_, err := d.CreateSensorReading(ctx, &models.SensorReading{SensorName: name, Value: 1})
+	require.NoError(t, err)
+
+	_, err = d.DeleteSensor(ctx, name, models.DeleteModeSoft)
+	require.NoError(t, err)
+
+	// the sensors row is still there, but the sensor is gone as far as writes are concerned
+	_, err = d.CreateSensorReading(ctx, &models.SensorReading{SensorName: name, Value: 2})
+	require.ErrorIs(t, err, ErrSensorNotFound)
+
+	// and its readings are no longer returned by name
+	page, err := d.GetSensorReadingsForTimeRange(ctx, models.TimeRangeQuery{
+		SensorName: name,
+		StartTime:  time.Now().Add(-time.Hour),
+		EndTime:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, page.SensorReadings)
+}
+
+func TestCreateSensorReadingsSoftDeletedSensor(t *testing.T) {
+	d := newTestDb(t)
+	ctx := context.Background()
+	live := createTestSensor(t, d)
+	deleted := createTestSensor(t, d)
+
+	_, err := d.DeleteSensor(ctx, deleted, models.DeleteModeSoft)
+	require.NoError(t, err)
+
+	// batches, streams and imports all write through CreateSensorReadings
+	itemErrs, err := d.CreateSensorReadings(ctx, []*models.SensorReading{
+		{SensorName: live, Value: 1},
+		{SensorName: deleted, Value: 2},
+	}, false)
+	require.NoError(t, err)
+	require.NoError(t, itemErrs[0])
+	require.ErrorIs(t, itemErrs[1], ErrSensorNotFound)
+}
+
+func TestFindSensorsInAreaAntimeridian(t *testing.T) {
+	d := newTestDb(t)
+	ctx := context.Background()
+	tag := t.Name() + "-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	for side, longitude := range map[string]float64{"east": 175, "west": -175, "greenwich": 0.5} {
+		name := tag + "-" + side
+
+		_, err := d.CreateSensor(ctx, &models.Sensor{
+			Name:     name,
+			Location: models.Location{Longitude: longitude, Latitude: -15},
+			Tags:     []string{tag},
+		})
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			d.ExecContext(context.Background(), `DELETE FROM sensors WHERE name = $1;`, name)
+		})
+	}
+
+	page, err := d.FindSensorsInArea(ctx, models.AreaQuery{
+		BoundingBox: &models.BoundingBox{MinLongitude: 170, MinLatitude: -20, MaxLongitude: -170, MaxLatitude: -10},
+		AllTags:     []string{tag},
+	})
+	require.NoError(t, err)
+
+	// the sensors either side of the antimeridian are in the box, the one on the other side of the world is not
+	require.Len(t, page.Sensors, 2)
+	require.Equal(t, tag+"-east", page.Sensors[0].Name)
+	require.Equal(t, tag+"-west", page.Sensors[1].Name)
+}
+
+func TestDefaultQuality(t *testing.T) {
+	reading := &models.SensorReading{}
+	require.NoError(t, defaultQuality(reading))
+	require.Equal(t, models.QualityGood, reading.Quality)
+
+	reading = &models.SensorReading{OutOfRange: true}
+	require.NoError(t, defaultQuality(reading))
+	require.Equal(t, models.QualitySuspect, reading.Quality)
+
+	// the database has no constraint on the column, so anything else must be refused here
+	require.ErrorIs(t, defaultQuality(&models.SensorReading{Quality: "great"}), ErrInvalidQuality)
+}
+
+func TestAggregateSensorReadingsSensorFilters(t *testing.T) {
+	d := newTestDb(t)
+	ctx := context.Background()
+	name := createTestSensor(t, d)
+
+	_, err := d.CreateSensorReading(ctx, &models.SensorReading{SensorName: name, Value: 1})
+	require.NoError(t, err)
+
+	query := models.AggregationQuery{
+		SensorName:  name,
+		StartTime:   time.Now().Add(-time.Hour),
+		EndTime:     time.Now().Add(time.Hour),
+		BucketWidth: models.Duration(time.Minute),
+		Functions:   []models.AggregateFunction{models.AggregateAvg},
+	}
+
+	buckets, err := d.AggregateSensorReadings(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, buckets, 1)
+
+	// the sensor does not carry the tag, so nothing is aggregated
+	query.Tags = []string{"not-" + name}
+	buckets, err = d.AggregateSensorReadings(ctx, query)
+	require.NoError(t, err)
+	require.Empty(t, buckets)
+
+	// nor once it has been soft-deleted
+	query.Tags = nil
+	_, err = d.DeleteSensor(ctx, name, models.DeleteModeSoft)
+	require.NoError(t, err)
+
+	buckets, err = d.AggregateSensorReadings(ctx, query)
+	require.NoError(t, err)
+	require.Empty(t, buckets)
+}
+
+func TestResampleSensorReadingsSoftDeletedSensor(t *testing.T) {
+	d := newTestDb(t)
+	ctx := context.Background()
+	name := createTestSensor(t, d)
+
+	_, err := d.CreateSensorReading(ctx, &models.SensorReading{SensorName: name, Value: 1})
+	require.NoError(t, err)
+
+	_, err = d.DeleteSensor(ctx, name, models.DeleteModeSoft)
+	require.NoError(t, err)
+
+	_, err = d.ResampleSensorReadings(ctx, models.ResampleQuery{
+		SensorName: name,
+		StartTime:  time.Now().Add(-time.Hour),
+		EndTime:    time.Now().Add(time.Hour),
+		Interval:   models.Duration(time.Minute),
+		Fill:       models.FillLOCF,
+	})
+	require.ErrorIs(t, err, ErrSensorNotFound)
+}
+
+func TestCreateSensorExisting(t *testing.T) {
+	d := newTestDb(t)
+	ctx := context.Background()
+	name := createTestSensor(t, d)
+
+	sensor := &models.Sensor{Name: name, Location: models.Location{Longitude: 1, Latitude: 1}, Tags: []string{"test"}}
+
+	_, err := d.CreateSensor(ctx, sensor)
+	require.ErrorIs(t, err, ErrSensorExists)
+
+	// a soft-deleted sensor keeps its name
+	_, err = d.DeleteSensor(ctx, name, models.DeleteModeSoft)
+	require.NoError(t, err)
+
+	_, err = d.CreateSensor(ctx, sensor)
+	require.ErrorIs(t, err, ErrSensorDeleted)
+}
@@ -0,0 +1,54 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- Continuous aggregates cannot be created or refreshed inside a transaction. The views keep enough state
+-- (sums, counts, sums of squares and the times of the first and last values) for every aggregate function
+-- to be rolled up into wider buckets or across sensors.
+CREATE MATERIALIZED VIEW IF NOT EXISTS sensor_readings_hourly
+WITH (timescaledb.continuous, timescaledb.materialized_only = false) AS
+SELECT time_bucket(INTERVAL '1 hour', time) AS time,
+       name,
+       count(value) AS value_count,
+       sum(value) AS value_sum,
+       sum(value * value) AS value_sum_squares,
+       min(value) AS value_min,
+       max(value) AS value_max,
+       first(value, time) AS first_value,
+       min(time) AS first_time,
+       last(value, time) AS last_value,
+       max(time) AS last_time
+FROM sensor_readings
+GROUP BY time_bucket(INTERVAL '1 hour', time), name;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS sensor_readings_daily
+WITH (timescaledb.continuous, timescaledb.materialized_only = false) AS
+SELECT time_bucket(INTERVAL '1 day', time) AS time,
+       name,
+       count(value) AS value_count,
+       sum(value) AS value_sum,
+       sum(value * value) AS value_sum_squares,
+       min(value) AS value_min,
+       max(value) AS value_max,
+       first(value, time) AS first_value,
+       min(time) AS first_time,
+       last(value, time) AS last_value,
+       max(time) AS last_time
+FROM sensor_readings
+GROUP BY time_bucket(INTERVAL '1 day', time), name;
+
+SELECT add_continuous_aggregate_policy('sensor_readings_hourly',
+    start_offset => INTERVAL '3 days',
+    end_offset => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '30 minutes',
+    if_not_exists => true);
+
+SELECT add_continuous_aggregate_policy('sensor_readings_daily',
+    start_offset => INTERVAL '30 days',
+    end_offset => INTERVAL '1 day',
+    schedule_interval => INTERVAL '1 hour',
+    if_not_exists => true);
+
+-- +goose Down
+SELECT remove_continuous_aggregate_policy('sensor_readings_daily', if_exists => true);
+SELECT remove_continuous_aggregate_policy('sensor_readings_hourly', if_exists => true);
+DROP MATERIALIZED VIEW IF EXISTS sensor_readings_daily;
+DROP MATERIALIZED VIEW IF EXISTS sensor_readings_hourly;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A reading is kept for the max_age of its sensor's rule, else the longest of its tags' rules, else the
+-- global rule. Readings of sensors no rule applies to are kept forever.
+CREATE TABLE IF NOT EXISTS retention_policies (
+    scope TEXT NOT NULL CHECK (scope IN ('global', 'tag', 'sensor')),
+    target TEXT NOT NULL DEFAULT '',
+    max_age INTERVAL NOT NULL CHECK (max_age > INTERVAL '0'),
+    PRIMARY KEY (scope, target),
+    CHECK ((scope = 'global') = (target = ''))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS retention_policies;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sensor_readings SET (
+    timescaledb.compress,
+    timescaledb.compress_segmentby = 'name',
+    timescaledb.compress_orderby = 'time DESC'
+);
+SELECT add_compression_policy('sensor_readings', INTERVAL '7 days', if_not_exists => true);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT remove_compression_policy('sensor_readings', if_exists => true);
+SELECT decompress_chunk(chunk, if_compressed => true) FROM show_chunks('sensor_readings') chunk;
+ALTER TABLE sensor_readings SET (timescaledb.compress = false);
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The latest reading of every sensor, kept up to date by a trigger so that it can be looked up without
+-- searching the hypertable.
+CREATE TABLE IF NOT EXISTS sensor_latest_readings (
+    name TEXT PRIMARY KEY REFERENCES sensors ON DELETE CASCADE,
+    value DOUBLE PRECISION NOT NULL,
+    time TIMESTAMPTZ NOT NULL
+);
+
+CREATE OR REPLACE FUNCTION record_latest_reading() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO sensor_latest_readings (name, value, time)
+    VALUES (NEW.name, NEW.value, NEW.time)
+    ON CONFLICT (name) DO UPDATE
+        SET value = EXCLUDED.value, time = EXCLUDED.time
+        WHERE sensor_latest_readings.time <= EXCLUDED.time;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sensor_readings_latest
+    AFTER INSERT ON sensor_readings
+    FOR EACH ROW EXECUTE FUNCTION record_latest_reading();
+
+INSERT INTO sensor_latest_readings (name, value, time)
+SELECT DISTINCT ON (name) name, value, time
+FROM sensor_readings
+ORDER BY name, time DESC
+ON CONFLICT (name) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS sensor_readings_latest ON sensor_readings;
+DROP FUNCTION IF EXISTS record_latest_reading();
+DROP TABLE IF EXISTS sensor_latest_readings;
+-- +goose StatementEnd
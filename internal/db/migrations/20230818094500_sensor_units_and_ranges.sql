@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Sensors describe what they measure and may bound the values they can sensibly report. Readings outside the
+-- range are either rejected or stored with out_of_range set, depending on the sensor's range_policy.
+ALTER TABLE sensors
+    ADD COLUMN IF NOT EXISTS measurement_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS unit TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS min_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS max_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS range_policy TEXT NOT NULL DEFAULT 'reject'
+        CHECK (range_policy IN ('reject', 'flag')),
+    ADD CONSTRAINT sensors_value_range_check CHECK (min_value <= max_value);
+
+-- compression is enabled on sensor_readings, so the column is added on its own and without a constraint
+ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS out_of_range BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE sensor_latest_readings ADD COLUMN IF NOT EXISTS out_of_range BOOLEAN NOT NULL DEFAULT false;
+
+CREATE OR REPLACE FUNCTION record_latest_reading() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO sensor_latest_readings (name, value, time, out_of_range)
+    VALUES (NEW.name, NEW.value, NEW.time, NEW.out_of_range)
+    ON CONFLICT (name) DO UPDATE
+        SET value = EXCLUDED.value, time = EXCLUDED.time, out_of_range = EXCLUDED.out_of_range
+        WHERE sensor_latest_readings.time <= EXCLUDED.time;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_latest_reading() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO sensor_latest_readings (name, value, time)
+    VALUES (NEW.name, NEW.value, NEW.time)
+    ON CONFLICT (name) DO UPDATE
+        SET value = EXCLUDED.value, time = EXCLUDED.time
+        WHERE sensor_latest_readings.time <= EXCLUDED.time;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE sensor_latest_readings DROP COLUMN IF EXISTS out_of_range;
+ALTER TABLE sensor_readings DROP COLUMN IF EXISTS out_of_range;
+
+ALTER TABLE sensors
+    DROP CONSTRAINT IF EXISTS sensors_value_range_check,
+    DROP COLUMN IF EXISTS range_policy,
+    DROP COLUMN IF EXISTS max_value,
+    DROP COLUMN IF EXISTS min_value,
+    DROP COLUMN IF EXISTS unit,
+    DROP COLUMN IF EXISTS measurement_type;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Readings carry a quality code and a free-text annotation, set when they are written and revised after
+-- review. The continuous aggregates keep rolling up readings of every quality.
+-- Compression is enabled on sensor_readings, so TimescaleDB only lets columns be added one at a time and without
+-- constraints; the database package checks the quality codes instead.
+ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS quality TEXT NOT NULL DEFAULT 'good';
+ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS annotation TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE sensor_latest_readings
+    ADD COLUMN IF NOT EXISTS quality TEXT NOT NULL DEFAULT 'good',
+    ADD COLUMN IF NOT EXISTS annotation TEXT NOT NULL DEFAULT '';
+
+CREATE OR REPLACE FUNCTION record_latest_reading() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO sensor_latest_readings (name, value, time, out_of_range, quality, annotation)
+    VALUES (NEW.name, NEW.value, NEW.time, NEW.out_of_range, NEW.quality, NEW.annotation)
+    ON CONFLICT (name) DO UPDATE
+        SET value = EXCLUDED.value, time = EXCLUDED.time, out_of_range = EXCLUDED.out_of_range,
+            quality = EXCLUDED.quality, annotation = EXCLUDED.annotation
+        WHERE sensor_latest_readings.time <= EXCLUDED.time;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_latest_reading() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO sensor_latest_readings (name, value, time, out_of_range)
+    VALUES (NEW.name, NEW.value, NEW.time, NEW.out_of_range)
+    ON CONFLICT (name) DO UPDATE
+        SET value = EXCLUDED.value, time = EXCLUDED.time, out_of_range = EXCLUDED.out_of_range
+        WHERE sensor_latest_readings.time <= EXCLUDED.time;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE sensor_latest_readings
+    DROP COLUMN IF EXISTS annotation,
+    DROP COLUMN IF EXISTS quality;
+
+ALTER TABLE sensor_readings DROP COLUMN IF EXISTS annotation;
+ALTER TABLE sensor_readings DROP COLUMN IF EXISTS quality;
+-- +goose StatementEnd
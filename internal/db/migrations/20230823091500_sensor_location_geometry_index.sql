@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Area searches test sensors on the longitude/latitude plane the areas are drawn on, so they need the
+-- location as a GEOMETRY indexed as well as the GEOGRAPHY index distance searches use.
+CREATE INDEX IF NOT EXISTS sensors_location_geometry_idx ON sensors USING GIST ((location::GEOMETRY));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS sensors_location_geometry_idx;
+-- +goose StatementEnd
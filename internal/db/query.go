@@ -0,0 +1,116 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	defaultReadingPageSize = 1000
+	maxReadingPageSize     = 10000
+)
+
+// queryArgs collects positional arguments while a statement is assembled and hands out their placeholders.
+type queryArgs []interface{}
+
+func (a *queryArgs) add(v interface{}) string {
+	*a = append(*a, v)
+
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// tagConditions filters on sensors.tags: the sensor must carry at least one of anyTags and every one of allTags.
+func tagConditions(args *queryArgs, anyTags, allTags []string) []string {
+	var conditions []string
+	if len(anyTags) > 0 {
+		conditions = append(conditions, "tags && "+args.add(pq.Array(anyTags))+"::TEXT[]")
+	}
+
+	if len(allTags) > 0 {
+		conditions = append(conditions, "tags @> "+args.add(pq.Array(allTags))+"::TEXT[]")
+	}
+
+	return conditions
+}
+
+// intervalString formats d as a PostgreSQL interval literal.
+func intervalString(d time.Duration) string {
+	return fmt.Sprintf("%d microseconds", d.Microseconds())
+}
+
+// likePrefix escapes LIKE wildcards in prefix so it only matches literally.
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+}
+
+func pageSizeOrDefault(pageSize int) int {
+	return clampPageSize(pageSize, defaultPageSize, maxPageSize)
+}
+
+func readingPageSizeOrDefault(pageSize int) int {
+	return clampPageSize(pageSize, defaultReadingPageSize, maxReadingPageSize)
+}
+
+func clampPageSize(pageSize, defaultSize, maxSize int) int {
+	switch {
+	case pageSize <= 0:
+		return defaultSize
+	case pageSize > maxSize:
+		return maxSize
+	default:
+		return pageSize
+	}
+}
+
+// sensorCursor is the position after the last sensor of a ListSensors page.
+type sensorCursor struct {
+	Name string `json:"n"`
+}
+
+// readingCursor is the position after the last reading of a GetSensorReadingsForTimeRange page.
+type readingCursor struct {
+	Time time.Time `json:"t"`
+	Name string    `json:"n"`
+}
+
+// distanceCursor is the position after the last sensor of a FindSensorsWithinRadius page.
+type distanceCursor struct {
+	Distance float64 `json:"d"`
+	Name     string  `json:"n"`
+}
+
+// encodePageToken turns a cursor into the opaque token handed to clients.
+func encodePageToken(cursor interface{}) (string, error) {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodePageToken reverses encodePageToken. An empty token leaves cursor untouched.
+func decodePageToken(token string, cursor interface{}) error {
+	if token == "" {
+		return nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ErrInvalidPageToken
+	}
+
+	if err = json.Unmarshal(b, cursor); err != nil {
+		return ErrInvalidPageToken
+	}
+
+	return nil
+}
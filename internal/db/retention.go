@@ -0,0 +1,329 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// maxAgeColumn reads an INTERVAL column as microseconds.
+const maxAgeColumn = "(EXTRACT(EPOCH FROM max_age) * 1000000)::BIGINT"
+
+func (d *Db) ListRetentionPolicies(ctx context.Context) ([]*models.RetentionPolicy, error) {
+	return listRetentionPolicies(ctx, d.DB)
+}
+
+// SetRetentionPolicy creates the policy or replaces the max age of the existing policy with the same scope
+// and target.
+func (d *Db) SetRetentionPolicy(ctx context.Context,
+	policy *models.RetentionPolicy) (*models.RetentionPolicy, error) {
+	_, err := d.ExecContext(ctx, `
+		INSERT INTO retention_policies (scope, target, max_age)
+		VALUES ($1, $2, $3::INTERVAL)
+		ON CONFLICT (scope, target) DO UPDATE SET max_age = EXCLUDED.max_age;`,
+		policy.Scope, policy.Target, intervalString(time.Duration(policy.MaxAge)))
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (d *Db) DeleteRetentionPolicy(ctx context.Context, scope models.RetentionScope, target string) error {
+	res, err := d.ExecContext(ctx, `
+		DELETE FROM retention_policies
+		WHERE scope = $1 AND target = $2;`, scope, target)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrRetentionPolicyNotFound
+	}
+
+	return nil
+}
+
+// ApplyRetention removes the readings that are older than the retention policy of their sensor. Chunks
+// holding only readings older than every sensor's policy are dropped whole, and the remaining expired
+// readings are deleted row by row. With dryRun the report is computed but nothing is removed.
+func (d *Db) ApplyRetention(ctx context.Context, dryRun bool) (*models.RetentionReport, error) {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var now time.Time
+	if err = tx.QueryRowContext(ctx, `SELECT NOW();`).Scan(&now); err != nil {
+		return nil, err
+	}
+
+	policies, err := listRetentionPolicies(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sensorTags, err := allSensorTags(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAges := effectiveRetention(policies, sensorTags)
+	report := &models.RetentionReport{
+		DryRun:        dryRun,
+		DroppedChunks: []string{},
+		Sensors:       []models.SensorRetention{},
+	}
+
+	sensorsByAge := map[time.Duration][]string{}
+	for name, maxAge := range maxAges {
+		sensorsByAge[maxAge] = append(sensorsByAge[maxAge], name)
+	}
+
+	for maxAge, names := range sensorsByAge {
+		expired, err := countExpiredReadings(ctx, tx, names, maxAge, now.Add(-maxAge))
+		if err != nil {
+			return nil, err
+		}
+
+		report.Sensors = append(report.Sensors, expired...)
+	}
+
+	sort.Slice(report.Sensors, func(i, j int) bool {
+		return report.Sensors[i].SensorName < report.Sensors[j].SensorName
+	})
+
+	for _, sensor := range report.Sensors {
+		report.ReadingsDeleted += sensor.ReadingsDeleted
+	}
+
+	// a chunk can only be dropped when every sensor that may have readings in it has a policy
+	if len(maxAges) > 0 && len(maxAges) == len(sensorTags) {
+		var longest time.Duration
+		for _, maxAge := range maxAges {
+			if maxAge > longest {
+				longest = maxAge
+			}
+		}
+
+		chunkFunction := "drop_chunks"
+		if dryRun {
+			chunkFunction = "show_chunks"
+		}
+
+		report.DroppedChunks, err = chunks(ctx, tx, chunkFunction, now.Add(-longest))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dryRun {
+		return report, nil
+	}
+
+	for maxAge, names := range sensorsByAge {
+		if err = deleteExpiredReadings(ctx, tx, names, now.Add(-maxAge)); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, tx.Commit()
+}
+
+// effectiveRetention resolves the max age of every sensor a policy applies to: its own policy, else the
+// longest policy of its tags, else the global policy.
+func effectiveRetention(policies []*models.RetentionPolicy, sensorTags map[string][]string) map[string]time.Duration {
+	var global time.Duration
+
+	bySensor := map[string]time.Duration{}
+	byTag := map[string]time.Duration{}
+
+	for _, policy := range policies {
+		switch policy.Scope {
+		case models.RetentionScopeGlobal:
+			global = time.Duration(policy.MaxAge)
+		case models.RetentionScopeTag:
+			byTag[policy.Target] = time.Duration(policy.MaxAge)
+		case models.RetentionScopeSensor:
+			bySensor[policy.Target] = time.Duration(policy.MaxAge)
+		}
+	}
+
+	maxAges := make(map[string]time.Duration, len(sensorTags))
+
+	for name, tags := range sensorTags {
+		if maxAge, ok := bySensor[name]; ok {
+			maxAges[name] = maxAge
+
+			continue
+		}
+
+		var maxAge time.Duration
+		for _, tag := range tags {
+			if byTag[tag] > maxAge {
+				maxAge = byTag[tag]
+			}
+		}
+
+		if maxAge == 0 {
+			maxAge = global
+		}
+
+		if maxAge > 0 {
+			maxAges[name] = maxAge
+		}
+	}
+
+	return maxAges
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func listRetentionPolicies(ctx context.Context, q querier) ([]*models.RetentionPolicy, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT scope, target, `+maxAgeColumn+`
+		FROM retention_policies
+		ORDER BY scope, target;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []*models.RetentionPolicy{}
+
+	for rows.Next() {
+		var (
+			policy models.RetentionPolicy
+			maxAge int64
+		)
+
+		if err = rows.Scan(&policy.Scope, &policy.Target, &maxAge); err != nil {
+			return nil, err
+		}
+
+		policy.MaxAge = models.Duration(time.Duration(maxAge) * time.Microsecond)
+		policies = append(policies, &policy)
+	}
+
+	return policies, rows.Err()
+}
+
+// allSensorTags includes soft-deleted sensors, whose readings are kept until they expire.
+func allSensorTags(ctx context.Context, q querier) (map[string][]string, error) {
+	rows, err := q.QueryContext(ctx, `SELECT name, tags FROM sensors;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sensorTags := map[string][]string{}
+
+	for rows.Next() {
+		var (
+			name string
+			tags []string
+		)
+
+		if err = rows.Scan(&name, pq.Array(&tags)); err != nil {
+			return nil, err
+		}
+
+		sensorTags[name] = tags
+	}
+
+	return sensorTags, rows.Err()
+}
+
+func countExpiredReadings(ctx context.Context, q querier, names []string, maxAge time.Duration,
+	cutoff time.Time,
+) ([]models.SensorRetention, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT name, count(*)
+		FROM sensor_readings
+		WHERE name = ANY($1) AND time < $2
+		GROUP BY name;`, pq.Array(names), cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expired []models.SensorRetention
+
+	for rows.Next() {
+		sensor := models.SensorRetention{MaxAge: models.Duration(maxAge), Cutoff: cutoff}
+		if err = rows.Scan(&sensor.SensorName, &sensor.ReadingsDeleted); err != nil {
+			return nil, err
+		}
+
+		expired = append(expired, sensor)
+	}
+
+	return expired, rows.Err()
+}
+
+// chunks calls show_chunks or drop_chunks on the readings hypertable and returns the chunk names.
+func chunks(ctx context.Context, q querier, function string, olderThan time.Time) ([]string, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT `+function+`('sensor_readings', older_than => $1::TIMESTAMPTZ)::TEXT;`, olderThan)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := []string{}
+
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+// deleteExpiredReadings deletes the readings of names taken before cutoff, decompressing the chunks they are in.
+// Latest readings that expired, including those in chunks that were dropped, are forgotten as well.
+func deleteExpiredReadings(ctx context.Context, tx *sql.Tx, names []string, cutoff time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM sensor_latest_readings
+		WHERE name = ANY($1) AND time < $2;`, pq.Array(names), cutoff)
+	if err != nil {
+		return err
+	}
+
+	var earliest sql.NullTime
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT min(time)
+		FROM sensor_readings
+		WHERE name = ANY($1) AND time < $2;`, pq.Array(names), cutoff).Scan(&earliest)
+	if err != nil || !earliest.Valid {
+		return err
+	}
+
+	if err = decompressChunks(ctx, tx, earliest.Time, cutoff); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM sensor_readings
+		WHERE name = ANY($1) AND time < $2;`, pq.Array(names), cutoff)
+
+	return err
+}
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+func TestEffectiveRetention(t *testing.T) {
+	day := 24 * time.Hour
+	policies := []*models.RetentionPolicy{
+		{Scope: models.RetentionScopeGlobal, MaxAge: models.Duration(365 * day)},
+		{Scope: models.RetentionScopeTag, Target: "debug", MaxAge: models.Duration(7 * day)},
+		{Scope: models.RetentionScopeTag, Target: "indoor", MaxAge: models.Duration(30 * day)},
+		{Scope: models.RetentionScopeSensor, Target: "critical", MaxAge: models.Duration(3650 * day)},
+	}
+	sensorTags := map[string][]string{
+		"critical": {"debug"},
+		"lab":      {"debug", "indoor"},
+		"probe":    {"debug"},
+		"roof":     {"outdoor"},
+	}
+
+	require.Equal(t, map[string]time.Duration{
+		"critical": 3650 * day,
+		"lab":      30 * day,
+		"probe":    7 * day,
+		"roof":     365 * day,
+	}, effectiveRetention(policies, sensorTags))
+
+	// without a global policy, sensors no other policy applies to are kept forever
+	require.NotContains(t, effectiveRetention(policies[1:], sensorTags), "roof")
+}
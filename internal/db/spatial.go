@@ -0,0 +1,292 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/twpayne/go-geom/encoding/wkt"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// FindNearestSensors returns the query.K sensors nearest the query's point, nearest first, each with its
+// distance in metres. The ordering uses the KNN operator so that the spatial index is walked outwards from the
+// point rather than every sensor being measured.
+func (d *Db) FindNearestSensors(ctx context.Context, query models.NearestQuery) ([]*models.SensorDistance, error) {
+	var args queryArgs
+
+	point := geographyPoint(&args, query.Longitude, query.Latitude)
+
+	conditions := []string{"deleted_at IS NULL", "location IS NOT NULL"}
+	if query.MaxDistance > 0 {
+		conditions = append(conditions, "ST_DWithin(location, "+point+", "+args.add(query.MaxDistance)+")")
+	}
+
+	conditions = append(conditions, tagConditions(&args, query.AnyTags, query.AllTags)...)
+
+	if query.ReportedWithin > 0 {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1
+			FROM sensor_latest_readings l
+			WHERE l.name = sensors.name AND l.time >= NOW() - `+
+			args.add(intervalString(time.Duration(query.ReportedWithin)))+`::INTERVAL
+		)`)
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT `+sensorColumns+`, ST_Distance(location, %[1]s) AS distance
+		FROM sensors
+		WHERE %[2]s
+		ORDER BY location <-> %[1]s, name
+		LIMIT %[3]d;`, point, strings.Join(conditions, " AND "), query.K)
+
+	return d.querySensorDistances(ctx, sqlStatement, args...)
+}
+
+// FindSensorsWithinRadius returns a page of the sensors within query.Radius metres of the query's point,
+// ordered by distance and then name, each with its distance in metres.
+func (d *Db) FindSensorsWithinRadius(ctx context.Context,
+	query models.RadiusQuery) (*models.SensorDistancePage, error) {
+	var cursor distanceCursor
+	if err := decodePageToken(query.PageToken, &cursor); err != nil {
+		return nil, err
+	}
+
+	pageSize := pageSizeOrDefault(query.PageSize)
+
+	var args queryArgs
+
+	point := geographyPoint(&args, query.Longitude, query.Latitude)
+	distance := "ST_Distance(location, " + point + ")"
+
+	conditions := []string{"deleted_at IS NULL", "ST_DWithin(location, " + point + ", " + args.add(query.Radius) + ")"}
+	conditions = append(conditions, tagConditions(&args, query.AnyTags, query.AllTags)...)
+
+	if cursor.Name != "" {
+		conditions = append(conditions,
+			"("+distance+", name) > ("+args.add(cursor.Distance)+", "+args.add(cursor.Name)+")")
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT `+sensorColumns+`, %s AS distance
+		FROM sensors
+		WHERE %s
+		ORDER BY distance, name
+		LIMIT %d;`, distance, strings.Join(conditions, " AND "), pageSize+1)
+
+	sensors, err := d.querySensorDistances(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.SensorDistancePage{Sensors: sensors}
+
+	// the extra row only tells us there is another page
+	if len(page.Sensors) > pageSize {
+		page.Sensors = page.Sensors[:pageSize]
+		last := page.Sensors[pageSize-1]
+
+		page.NextPageToken, err = encodePageToken(distanceCursor{Distance: last.Distance, Name: last.Name})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// FindSensorsInArea returns a page of the sensors inside query's bounding box or area, boundary included,
+// ordered by name. A bounding box may cross the antimeridian. Sensors are tested on the longitude/latitude plane,
+// so the edges of the area are the straight lines drawn on a map rather than great circles.
+func (d *Db) FindSensorsInArea(ctx context.Context, query models.AreaQuery) (*models.SensorPage, error) {
+	var cursor sensorCursor
+	if err := decodePageToken(query.PageToken, &cursor); err != nil {
+		return nil, err
+	}
+
+	pageSize := pageSizeOrDefault(query.PageSize)
+
+	var args queryArgs
+
+	conditions := []string{"deleted_at IS NULL"}
+
+	if box := query.BoundingBox; box != nil {
+		envelope := func(minLongitude, maxLongitude float64) string {
+			return fmt.Sprintf("ST_Intersects(ST_MakeEnvelope(%s, %s, %s, %s, 4326), location::GEOMETRY)",
+				args.add(minLongitude), args.add(box.MinLatitude), args.add(maxLongitude), args.add(box.MaxLatitude))
+		}
+
+		if box.MinLongitude <= box.MaxLongitude {
+			conditions = append(conditions, envelope(box.MinLongitude, box.MaxLongitude))
+		} else {
+			// a box crossing the antimeridian is the two boxes either side of it
+			conditions = append(conditions, "("+envelope(box.MinLongitude, 180)+" OR "+envelope(-180, box.MaxLongitude)+")")
+		}
+	} else {
+		area, err := wkt.Marshal(query.Area)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions,
+			"ST_Covers(ST_Force2D(ST_GeomFromText("+args.add(area)+", 4326)), location::GEOMETRY)")
+	}
+
+	conditions = append(conditions, tagConditions(&args, query.AnyTags, query.AllTags)...)
+
+	if cursor.Name != "" {
+		conditions = append(conditions, "name > "+args.add(cursor.Name))
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT `+sensorColumns+`
+		FROM sensors
+		WHERE %s
+		ORDER BY name
+		LIMIT %d;`, strings.Join(conditions, " AND "), pageSize+1)
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &models.SensorPage{Sensors: []*models.Sensor{}}
+
+	for rows.Next() {
+		sensor, err := scanSensor(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Sensors = append(page.Sensors, sensor)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// the extra row only tells us there is another page
+	if len(page.Sensors) > pageSize {
+		page.Sensors = page.Sensors[:pageSize]
+		page.NextPageToken, err = encodePageToken(sensorCursor{Name: page.Sensors[pageSize-1].Name})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// GetInterpolationSources returns the query.K sensors nearest the query's point that have a reading within
+// query.Window of query.Time, nearest first, each with its distance in metres and its reading closest to
+// query.Time. Sensors without such a reading are skipped, so the K sources may reach further out than the K
+// nearest sensors.
+func (d *Db) GetInterpolationSources(ctx context.Context,
+	query models.InterpolationQuery) ([]*models.InterpolationSource, error) {
+	var args queryArgs
+
+	point := geographyPoint(&args, query.Longitude, query.Latitude)
+	at := args.add(query.Time) + "::TIMESTAMPTZ"
+	window := args.add(intervalString(time.Duration(query.Window))) + "::INTERVAL"
+
+	quality := ""
+	if query.GoodOnly {
+		quality = " AND quality = 'good'"
+	}
+
+	conditions := []string{"deleted_at IS NULL", "location IS NOT NULL"}
+	if query.MaxDistance > 0 {
+		conditions = append(conditions, "ST_DWithin(location, "+point+", "+args.add(query.MaxDistance)+")")
+	}
+
+	if query.MeasurementType != "" {
+		conditions = append(conditions, "measurement_type = "+args.add(query.MeasurementType))
+	}
+
+	conditions = append(conditions, tagConditions(&args, query.AnyTags, query.AllTags)...)
+
+	// the readings just before and just after the time are found with the (name, time) index and the closer of
+	// the two is kept
+	sqlStatement := fmt.Sprintf(`
+		SELECT `+sensorColumns+`, ST_Distance(location, %[1]s) AS distance, r.time, r.value
+		FROM sensors
+		CROSS JOIN LATERAL (
+			SELECT time, value
+			FROM (
+				(SELECT time, value
+				FROM sensor_readings
+				WHERE name = sensors.name AND time <= %[2]s AND time >= %[2]s - %[3]s%[4]s
+				ORDER BY time DESC
+				LIMIT 1)
+				UNION ALL
+				(SELECT time, value
+				FROM sensor_readings
+				WHERE name = sensors.name AND time > %[2]s AND time <= %[2]s + %[3]s%[4]s
+				ORDER BY time
+				LIMIT 1)
+			) around
+			ORDER BY ABS(EXTRACT(EPOCH FROM around.time - %[2]s))
+			LIMIT 1
+		) r
+		WHERE %[5]s
+		ORDER BY location <-> %[1]s, name
+		LIMIT %[6]d;`, point, at, window, quality, strings.Join(conditions, " AND "), query.K)
+
+	rows, err := d.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sources := []*models.InterpolationSource{}
+
+	for rows.Next() {
+		source := &models.InterpolationSource{}
+
+		sensor, err := scanSensor(rows, &source.Distance, &source.Time, &source.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		source.SensorName = sensor.Name
+		source.Location = sensor.Location
+		source.Unit = sensor.Unit
+
+		sources = append(sources, source)
+	}
+
+	return sources, rows.Err()
+}
+
+// querySensorDistances runs a query for sensorColumns followed by a distance.
+func (d *Db) querySensorDistances(ctx context.Context, query string,
+	args ...interface{}) ([]*models.SensorDistance, error) {
+	rows, err := d.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sensors := []*models.SensorDistance{}
+
+	for rows.Next() {
+		var distance float64
+
+		sensor, err := scanSensor(rows, &distance)
+		if err != nil {
+			return nil, err
+		}
+
+		sensors = append(sensors, &models.SensorDistance{Sensor: *sensor, Distance: distance})
+	}
+
+	return sensors, rows.Err()
+}
+
+// geographyPoint adds longitude and latitude to args and returns the expression of the GEOGRAPHY point at them.
+func geographyPoint(args *queryArgs, longitude, latitude float64) string {
+	return fmt.Sprintf("ST_SetSRID(ST_MakePoint(%s, %s), 4326)::GEOGRAPHY", args.add(longitude), args.add(latitude))
+}
@@ -0,0 +1,254 @@
+// Package interpolation estimates the value at a point from values measured at points around it.
+//
+// Samples are placed on a plane in metres with the point being estimated at the origin; Project places
+// longitude/latitude positions on such a plane.
+package interpolation
+
+import (
+	"errors"
+	"math"
+)
+
+// earthRadius is the mean radius of the Earth in metres.
+const earthRadius = 6371008.8
+
+var (
+	ErrNoSamples = errors.New("no samples to interpolate from")
+	// ErrCoincidentSamples is returned by kriging when two samples share a position, which leaves the kriging
+	// system without a solution.
+	ErrCoincidentSamples = errors.New("samples share a position")
+)
+
+// Sample is a value measured at X metres east and Y metres north of the point being estimated.
+type Sample struct {
+	X, Y  float64
+	Value float64
+}
+
+func (s Sample) distance() float64 {
+	return math.Hypot(s.X, s.Y)
+}
+
+// Estimate is an interpolated value. Weights holds the weight of each sample in the value, in the order the
+// samples were given, and adds up to 1.
+type Estimate struct {
+	Value   float64
+	Weights []float64
+	// Variance is the kriging variance of the value; it is zero for the other methods.
+	Variance float64
+}
+
+// Project returns the position in metres east and north of the origin longitude/latitude of a longitude/latitude,
+// using an equirectangular projection centred on the origin. Distortion is small over the tens of kilometres
+// sensors are interpolated across.
+func Project(originLongitude, originLatitude, longitude, latitude float64) (x, y float64) {
+	dLongitude := math.Remainder(longitude-originLongitude, 360)
+	x = earthRadius * radians(dLongitude) * math.Cos(radians(originLatitude))
+	y = earthRadius * radians(latitude-originLatitude)
+
+	return x, y
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// NearestNeighbour estimates the value as that of the nearest sample, the first of them on a tie.
+func NearestNeighbour(samples []Sample) (Estimate, error) {
+	if len(samples) == 0 {
+		return Estimate{}, ErrNoSamples
+	}
+
+	nearest := 0
+	for i, sample := range samples {
+		if sample.distance() < samples[nearest].distance() {
+			nearest = i
+		}
+	}
+
+	return exactly(samples, nearest), nil
+}
+
+// InverseDistance estimates the value by inverse distance weighting: the weighted mean of the samples, each
+// weighted by its distance to the power of -power. A sample at the origin is the value.
+func InverseDistance(samples []Sample, power float64) (Estimate, error) {
+	if len(samples) == 0 {
+		return Estimate{}, ErrNoSamples
+	}
+
+	if i, ok := atOrigin(samples); ok {
+		return exactly(samples, i), nil
+	}
+
+	weights := make([]float64, len(samples))
+
+	var total float64
+	for i, sample := range samples {
+		weights[i] = math.Pow(sample.distance(), -power)
+		total += weights[i]
+	}
+
+	estimate := Estimate{Weights: weights}
+	for i, sample := range samples {
+		weights[i] /= total
+		estimate.Value += weights[i] * sample.Value
+	}
+
+	return estimate, nil
+}
+
+// OrdinaryKriging estimates the value by ordinary kriging with an exponential variogram without nugget, whose
+// sill is the variance of the samples and whose practical range is rangeMetres. A rangeMetres of 0 uses the
+// greatest distance between two samples, or from a sample to the origin.
+//
+// This is kriging without fitting a variogram to the data, so the estimate is best treated as a smoother
+// alternative to inverse distance weighting that also accounts for samples clustering together.
+func OrdinaryKriging(samples []Sample, rangeMetres float64) (Estimate, error) {
+	if len(samples) == 0 {
+		return Estimate{}, ErrNoSamples
+	}
+
+	if i, ok := atOrigin(samples); ok {
+		return exactly(samples, i), nil
+	}
+
+	if rangeMetres <= 0 {
+		rangeMetres = greatestDistance(samples)
+	}
+
+	// the semivariance at distance h, for a unit sill; the weights do not depend on the sill
+	gamma := func(h float64) float64 {
+		return 1 - math.Exp(-3*h/rangeMetres)
+	}
+
+	// The system is the semivariances between the samples, bordered by the Lagrange multiplier row and column
+	// that make the weights add up to 1.
+	n := len(samples)
+	system := make([][]float64, n+1)
+
+	for i := range system {
+		system[i] = make([]float64, n+2)
+
+		for j := 0; j < n; j++ {
+			if i == n {
+				system[i][j] = 1
+				continue
+			}
+
+			system[i][j] = gamma(math.Hypot(samples[i].X-samples[j].X, samples[i].Y-samples[j].Y))
+		}
+
+		if i < n {
+			system[i][n] = 1
+			system[i][n+1] = gamma(samples[i].distance())
+		} else {
+			system[i][n+1] = 1
+		}
+	}
+
+	solution, err := solve(system)
+	if err != nil {
+		return Estimate{}, err
+	}
+
+	estimate := Estimate{Weights: solution[:n]}
+
+	// the kriging variance is the weighted semivariance to the origin plus the Lagrange multiplier
+	variance := solution[n]
+	for i, sample := range samples {
+		estimate.Value += estimate.Weights[i] * sample.Value
+		variance += estimate.Weights[i] * gamma(sample.distance())
+	}
+
+	estimate.Variance = math.Max(variance, 0) * sampleVariance(samples)
+
+	return estimate, nil
+}
+
+// solve solves the linear system whose augmented matrix is system by Gaussian elimination with partial pivoting.
+func solve(system [][]float64) ([]float64, error) {
+	n := len(system)
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(system[row][col]) > math.Abs(system[pivot][col]) {
+				pivot = row
+			}
+		}
+
+		if math.Abs(system[pivot][col]) < 1e-12 {
+			return nil, ErrCoincidentSamples
+		}
+
+		system[col], system[pivot] = system[pivot], system[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := system[row][col] / system[col][col]
+			for k := col; k <= n; k++ {
+				system[row][k] -= factor * system[col][k]
+			}
+		}
+	}
+
+	solution := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := system[row][n]
+		for k := row + 1; k < n; k++ {
+			sum -= system[row][k] * solution[k]
+		}
+
+		solution[row] = sum / system[row][row]
+	}
+
+	return solution, nil
+}
+
+// atOrigin returns the index of a sample at the origin, if there is one.
+func atOrigin(samples []Sample) (int, bool) {
+	for i, sample := range samples {
+		if sample.distance() == 0 {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// exactly returns the value of the i'th sample as the estimate.
+func exactly(samples []Sample, i int) Estimate {
+	weights := make([]float64, len(samples))
+	weights[i] = 1
+
+	return Estimate{Value: samples[i].Value, Weights: weights}
+}
+
+func greatestDistance(samples []Sample) float64 {
+	var greatest float64
+
+	for i, sample := range samples {
+		greatest = math.Max(greatest, sample.distance())
+
+		for _, other := range samples[i+1:] {
+			greatest = math.Max(greatest, math.Hypot(sample.X-other.X, sample.Y-other.Y))
+		}
+	}
+
+	return greatest
+}
+
+func sampleVariance(samples []Sample) float64 {
+	var mean float64
+	for _, sample := range samples {
+		mean += sample.Value
+	}
+
+	mean /= float64(len(samples))
+
+	var variance float64
+	for _, sample := range samples {
+		variance += (sample.Value - mean) * (sample.Value - mean)
+	}
+
+	return variance / float64(len(samples))
+}
@@ -0,0 +1,103 @@
+package interpolation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInverseDistance(t *testing.T) {
+	samples := []Sample{
+		{X: 100, Y: 0, Value: 10},
+		{X: 0, Y: -200, Value: 20},
+	}
+
+	estimate, err := InverseDistance(samples, 2)
+	require.NoError(t, err)
+
+	// weights are 1/100² and 1/200², i.e. 4:1
+	require.InDeltaSlice(t, []float64{0.8, 0.2}, estimate.Weights, 1e-9)
+	require.InDelta(t, 12, estimate.Value, 1e-9)
+	require.Zero(t, estimate.Variance)
+}
+
+func TestInverseDistanceSampleAtOrigin(t *testing.T) {
+	estimate, err := InverseDistance([]Sample{{X: 100, Value: 10}, {Value: 15}}, 2)
+	require.NoError(t, err)
+	require.Equal(t, 15.0, estimate.Value)
+	require.Equal(t, []float64{0, 1}, estimate.Weights)
+}
+
+func TestNearestNeighbour(t *testing.T) {
+	estimate, err := NearestNeighbour([]Sample{{X: 300, Value: 10}, {Y: 50, Value: 20}, {X: -50, Value: 30}})
+	require.NoError(t, err)
+	require.Equal(t, 20.0, estimate.Value)
+	require.Equal(t, []float64{0, 1, 0}, estimate.Weights)
+}
+
+func TestOrdinaryKriging(t *testing.T) {
+	t.Run("symmetric samples weigh the same", func(t *testing.T) {
+		samples := []Sample{
+			{X: 100, Value: 10},
+			{X: -100, Value: 20},
+			{Y: 100, Value: 30},
+			{Y: -100, Value: 40},
+		}
+
+		estimate, err := OrdinaryKriging(samples, 1000)
+		require.NoError(t, err)
+		require.InDeltaSlice(t, []float64{0.25, 0.25, 0.25, 0.25}, estimate.Weights, 1e-9)
+		require.InDelta(t, 25, estimate.Value, 1e-9)
+		require.Greater(t, estimate.Variance, 0.0)
+	})
+
+	t.Run("weights add up to one", func(t *testing.T) {
+		samples := []Sample{
+			{X: 120, Y: 40, Value: 10},
+			{X: -300, Y: 80, Value: 14},
+			{X: 20, Y: -500, Value: 11},
+			{X: 130, Y: 60, Value: 12},
+		}
+
+		estimate, err := OrdinaryKriging(samples, 0)
+		require.NoError(t, err)
+
+		var total float64
+		for _, weight := range estimate.Weights {
+			total += weight
+		}
+
+		require.InDelta(t, 1, total, 1e-9)
+		require.True(t, estimate.Value >= 10 && estimate.Value <= 14, "estimate %v", estimate.Value)
+		// the nearest sample counts for more than the furthest
+		require.Greater(t, estimate.Weights[0], estimate.Weights[2])
+	})
+
+	t.Run("coincident samples", func(t *testing.T) {
+		_, err := OrdinaryKriging([]Sample{{X: 100, Value: 10}, {X: 100, Value: 12}}, 1000)
+		require.ErrorIs(t, err, ErrCoincidentSamples)
+	})
+}
+
+func TestNoSamples(t *testing.T) {
+	_, err := InverseDistance(nil, 2)
+	require.ErrorIs(t, err, ErrNoSamples)
+
+	_, err = NearestNeighbour(nil)
+	require.ErrorIs(t, err, ErrNoSamples)
+
+	_, err = OrdinaryKriging(nil, 0)
+	require.ErrorIs(t, err, ErrNoSamples)
+}
+
+func TestProject(t *testing.T) {
+	// a degree of latitude is about 111.2km everywhere; a degree of longitude shrinks with the latitude
+	x, y := Project(0, 60, 1, 61)
+	require.InDelta(t, 111195*math.Cos(math.Pi/3), x, 1)
+	require.InDelta(t, 111195, y, 1)
+
+	// longitudes either side of the antimeridian are close together
+	x, _ = Project(179.5, 0, -179.5, 0)
+	require.InDelta(t, 111195, x, 1)
+}
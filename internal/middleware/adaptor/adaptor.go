@@ -3,6 +3,7 @@ package adaptor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 
@@ -11,6 +12,24 @@ import (
 	"go.uber.org/zap"
 )
 
+// HttpError lets a handler choose the status code GenericHttpAdaptor responds with.
+type HttpError struct {
+	Code int
+	Err  error
+}
+
+func NewHttpError(code int, err error) *HttpError {
+	return &HttpError{Code: code, Err: err}
+}
+
+func (e *HttpError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *HttpError) Unwrap() error {
+	return e.Err
+}
+
 func GenericDecoder[T any](r *http.Request) (in T, err error) {
 	ptrIn := new(T)
 
@@ -18,9 +37,8 @@ func GenericDecoder[T any](r *http.Request) (in T, err error) {
 	err = json.NewDecoder(r.Body).Decode(ptrIn)
 
 	if err != nil && err == io.EOF {
-		// If the body is empty, try to decode from URL parameters
-		vars := mux.Vars(r)
-		err = mapstructure.Decode(vars, ptrIn)
+		// If the body is empty, try to decode from URL and query parameters
+		err = decodeParams(r, ptrIn)
 	}
 
 	in = *ptrIn
@@ -28,6 +46,34 @@ func GenericDecoder[T any](r *http.Request) (in T, err error) {
 	return in, err
 }
 
+func decodeParams(r *http.Request, out any) error {
+	params := map[string]interface{}{}
+
+	for key, values := range r.URL.Query() {
+		if len(values) == 1 {
+			params[key] = values[0]
+		} else {
+			params[key] = values
+		}
+	}
+
+	for key, value := range mux.Vars(r) {
+		params[key] = value
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		// parses parameters such as times into types that implement encoding.TextUnmarshaler
+		DecodeHook:       mapstructure.TextUnmarshallerHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(params)
+}
+
 func GenericEncoder[T any](w http.ResponseWriter, out T) error {
 	json.NewEncoder(w).Encode(out)
 	w.Header().Add("Content-Type", "application/json")
@@ -36,6 +82,16 @@ func GenericEncoder[T any](w http.ResponseWriter, out T) error {
 }
 
 func GenericHttpAdaptor[TIN any, TOUT any](f func(context.Context, TIN) (TOUT, error)) http.HandlerFunc {
+	return GenericHttpAdaptorWithEncoder(f, func(w http.ResponseWriter, r *http.Request, out TOUT) error {
+		return GenericEncoder(w, out)
+	})
+}
+
+// GenericHttpAdaptorWithEncoder is GenericHttpAdaptor with encode writing f's output, so that handlers can offer
+// other representations of it, such as GeoJSON, to clients that ask for them.
+func GenericHttpAdaptorWithEncoder[TIN any, TOUT any](f func(context.Context, TIN) (TOUT, error),
+	encode func(http.ResponseWriter, *http.Request, TOUT) error,
+) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		in, err := GenericDecoder[TIN](r)
 		if err != nil {
@@ -47,13 +103,20 @@ func GenericHttpAdaptor[TIN any, TOUT any](f func(context.Context, TIN) (TOUT, e
 
 		out, err := f(r.Context(), in)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			code := http.StatusBadRequest
+
+			var httpErr *HttpError
+			if errors.As(err, &httpErr) {
+				code = httpErr.Code
+			}
+
+			http.Error(w, err.Error(), code)
 			zap.L().Sugar().Error(err, r)
 
 			return
 		}
 
-		err = GenericEncoder(w, out)
+		err = encode(w, r, out)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			zap.L().Sugar().Error(err, r)
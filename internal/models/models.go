@@ -1,19 +1,82 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/twpayne/go-geom"
 )
 
 type Sensor struct {
-	Name     string   `json:"name"`
-	Location Location `json:"location"`
-	Tags     []string `json:"tags"`
+	Name            string      `json:"name"`
+	Location        Location    `json:"location"`
+	Tags            []string    `json:"tags"`
+	MeasurementType string      `json:"measurementType,omitempty"`
+	Unit            string      `json:"unit,omitempty"`
+	MinValue        *float64    `json:"minValue,omitempty"`
+	MaxValue        *float64    `json:"maxValue,omitempty"`
+	RangePolicy     RangePolicy `json:"rangePolicy,omitempty"`
 }
 
+// RangePolicy decides what happens to a reading outside its sensor's valid range.
+type RangePolicy string
+
+const (
+	RangePolicyReject RangePolicy = "reject"
+	RangePolicyFlag   RangePolicy = "flag"
+)
+
+// InRange reports whether value lies within the sensor's valid range. Missing bounds are unbounded.
+func (s *Sensor) InRange(value float64) bool {
+	return (s.MinValue == nil || value >= *s.MinValue) && (s.MaxValue == nil || value <= *s.MaxValue)
+}
+
+// SensorReading is a value reported by a sensor. Unit is the unit of its sensor; OutOfRange is set on readings
+// stored despite lying outside their sensor's valid range.
 type SensorReading struct {
 	SensorName string    `json:"sensorName"`
 	Time       time.Time `json:"time"`
 	Value      float64   `json:"value"`
+	Unit       string    `json:"unit,omitempty"`
+	OutOfRange bool      `json:"outOfRange,omitempty"`
+	Quality    Quality   `json:"quality,omitempty"`
+	Annotation string    `json:"annotation,omitempty"`
+}
+
+// Quality says how far a reading can be trusted. Readings are good unless written or flagged otherwise,
+// except that readings stored out of range start out suspect.
+type Quality string
+
+const (
+	QualityGood      Quality = "good"
+	QualitySuspect   Quality = "suspect"
+	QualityBad       Quality = "bad"
+	QualityEstimated Quality = "estimated"
+)
+
+// Valid reports whether q is one of the quality codes.
+func (q Quality) Valid() bool {
+	switch q {
+	case QualityGood, QualitySuspect, QualityBad, QualityEstimated:
+		return true
+	}
+
+	return false
+}
+
+// FlagReadingsRequest sets the quality and annotation of a sensor's readings between StartTime and EndTime
+// (both inclusive).
+type FlagReadingsRequest struct {
+	SensorName string    `json:"sensorName"`
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+	Quality    Quality   `json:"quality"`
+	Annotation string    `json:"annotation"`
+}
+
+type FlagReadingsResponse struct {
+	Updated int64 `json:"updated"`
 }
 
 type Location struct {
@@ -25,4 +88,450 @@ type TimeRangeQuery struct {
 	StartTime  time.Time `json:"startTime"`
 	EndTime    time.Time `json:"endTime"`
 	SensorName string    `json:"sensorName"`
+	PageSize   int       `json:"pageSize"`
+	PageToken  string    `json:"pageToken"`
+	Order      SortOrder `json:"order"`
+	// GoodOnly leaves out readings whose quality is not good.
+	GoodOnly bool `json:"goodOnly"`
+}
+
+type SensorReadingPage struct {
+	SensorReadings []*SensorReading `json:"sensorReadings"`
+	NextPageToken  string           `json:"nextPageToken,omitempty"`
+}
+
+// LatestReadingsQuery selects the sensors named in SensorNames and/or carrying all of Tags.
+type LatestReadingsQuery struct {
+	SensorNames []string `json:"sensorNames"`
+	Tags        []string `json:"tags"`
+	// GoodOnly returns each sensor's latest good reading instead.
+	GoodOnly bool `json:"goodOnly"`
+}
+
+// DeleteMode controls what happens to a sensor's readings when the sensor is deleted.
+type DeleteMode string
+
+const (
+	// DeleteModeRestrict refuses to delete a sensor that still has readings.
+	DeleteModeRestrict DeleteMode = "restrict"
+	// DeleteModeCascade deletes the sensor together with all of its readings.
+	DeleteModeCascade DeleteMode = "cascade"
+	// DeleteModeSoft marks the sensor as deleted and keeps its readings.
+	DeleteModeSoft DeleteMode = "soft"
+)
+
+type DeleteSensorRequest struct {
+	Name string     `json:"name"`
+	Mode DeleteMode `json:"mode"`
+}
+
+type DeleteSensorResponse struct {
+	Name            string     `json:"name"`
+	Mode            DeleteMode `json:"mode"`
+	ReadingsDeleted int64      `json:"readingsDeleted"`
+}
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+type ListSensorsQuery struct {
+	PageSize   int       `json:"pageSize"`
+	PageToken  string    `json:"pageToken"`
+	NamePrefix string    `json:"namePrefix"`
+	AnyTags    []string  `json:"anyTags"`
+	AllTags    []string  `json:"allTags"`
+	Order      SortOrder `json:"order"`
+}
+
+type SensorPage struct {
+	Sensors       []*Sensor `json:"sensors"`
+	NextPageToken string    `json:"nextPageToken,omitempty"`
+}
+
+// RadiusQuery selects the sensors within Radius metres of a point, nearest first.
+type RadiusQuery struct {
+	Longitude float64  `json:"longitude"`
+	Latitude  float64  `json:"latitude"`
+	Radius    float64  `json:"radius"`
+	AnyTags   []string `json:"anyTags"`
+	AllTags   []string `json:"allTags"`
+	PageSize  int      `json:"pageSize"`
+	PageToken string   `json:"pageToken"`
+}
+
+// NearestQuery selects the K sensors nearest a point. MaxDistance, in metres, and ReportedWithin leave out
+// sensors further away than that and sensors whose latest reading is older than that; zero disables them.
+type NearestQuery struct {
+	Longitude      float64  `json:"longitude"`
+	Latitude       float64  `json:"latitude"`
+	K              int      `json:"k"`
+	MaxDistance    float64  `json:"maxDistance"`
+	AnyTags        []string `json:"anyTags"`
+	AllTags        []string `json:"allTags"`
+	ReportedWithin Duration `json:"reportedWithin" swaggertype:"string" example:"15m"`
+}
+
+type NearestSensorsResponse struct {
+	Sensors []*SensorDistance `json:"sensors"`
+}
+
+// AreaQuery selects the sensors inside either a bounding box or a GeoJSON Polygon or MultiPolygon geometry,
+// boundary included, a page at a time ordered by name.
+type AreaQuery struct {
+	BoundingBox *BoundingBox    `json:"bbox,omitempty"`
+	Geometry    json.RawMessage `json:"geometry,omitempty" swaggertype:"object"`
+	AnyTags     []string        `json:"anyTags"`
+	AllTags     []string        `json:"allTags"`
+	PageSize    int             `json:"pageSize"`
+	PageToken   string          `json:"pageToken"`
+	// Area is Geometry once it has been parsed.
+	Area geom.T `json:"-"`
+}
+
+// InterpolationMethod chooses how an InterpolationQuery estimates the value at its point.
+type InterpolationMethod string
+
+const (
+	// InterpolationIDW weights the readings by their inverse distance to the power of Power.
+	InterpolationIDW InterpolationMethod = "idw"
+	// InterpolationNearest takes the reading of the nearest sensor.
+	InterpolationNearest InterpolationMethod = "nearest"
+	// InterpolationKriging uses ordinary kriging with an exponential variogram whose practical range is Range.
+	InterpolationKriging InterpolationMethod = "kriging"
+)
+
+// InterpolationQuery estimates the value at a point and time from the readings of the K sensors nearest the
+// point that have a reading within Window of Time. The reading of each sensor closest to Time is used.
+type InterpolationQuery struct {
+	Longitude       float64             `json:"longitude"`
+	Latitude        float64             `json:"latitude"`
+	Time            time.Time           `json:"time"`
+	Method          InterpolationMethod `json:"method"`
+	K               int                 `json:"k"`
+	Window          Duration            `json:"window" swaggertype:"string" example:"15m"`
+	MaxDistance     float64             `json:"maxDistance"`
+	MeasurementType string              `json:"measurementType"`
+	AnyTags         []string            `json:"anyTags"`
+	AllTags         []string            `json:"allTags"`
+	GoodOnly        bool                `json:"goodOnly"`
+	// Power is the IDW exponent, 2 by default.
+	Power float64 `json:"power"`
+	// Range is the kriging practical range in metres; by default the furthest the sensors are apart.
+	Range float64 `json:"range"`
+}
+
+// InterpolationSource is a sensor whose reading contributed to an interpolated value, with its distance in
+// metres from the point and its weight in the value.
+type InterpolationSource struct {
+	SensorName string    `json:"sensorName"`
+	Location   Location  `json:"location"`
+	Distance   float64   `json:"distance"`
+	Time       time.Time `json:"time"`
+	Value      float64   `json:"value"`
+	Unit       string    `json:"unit,omitempty"`
+	Weight     float64   `json:"weight"`
+}
+
+// Interpolation is the value estimated at a point and time and the sensors it was estimated from.
+type Interpolation struct {
+	Value  float64             `json:"value"`
+	Unit   string              `json:"unit,omitempty"`
+	Method InterpolationMethod `json:"method"`
+	// Variance is the kriging variance of the value.
+	Variance *float64               `json:"variance,omitempty"`
+	Sensors  []*InterpolationSource `json:"sensors"`
+}
+
+// SensorDistance is a sensor along with its distance in metres from the point a search was made around.
+type SensorDistance struct {
+	Sensor
+	Distance float64 `json:"distance"`
+}
+
+type SensorDistancePage struct {
+	Sensors       []*SensorDistance `json:"sensors"`
+	NextPageToken string            `json:"nextPageToken,omitempty"`
+}
+
+type BatchSensorReadingsRequest struct {
+	Readings []*SensorReading `json:"readings"`
+	// Atomic writes either every reading or none of them. Otherwise every valid reading is written.
+	Atomic bool `json:"atomic"`
+}
+
+type BatchItemResult struct {
+	Index   int            `json:"index"`
+	Reading *SensorReading `json:"reading,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+type BatchSensorReadingsResponse struct {
+	Accepted int               `json:"accepted"`
+	Rejected int               `json:"rejected"`
+	Results  []BatchItemResult `json:"results"`
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV    ImportFormat = "csv"
+	ImportFormatNDJSON ImportFormat = "ndjson"
+)
+
+// ImportRejection is a line of an import that was not stored. Line is 1-based and counts the CSV header.
+type ImportRejection struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// ImportReport is the outcome of an import. Rejections lists the first rejected lines only; Rejected counts
+// all of them.
+type ImportReport struct {
+	Accepted            int64             `json:"accepted"`
+	Rejected            int64             `json:"rejected"`
+	CreatedSensors      []string          `json:"createdSensors"`
+	Rejections          []ImportRejection `json:"rejections"`
+	RejectionsTruncated bool              `json:"rejectionsTruncated,omitempty"`
+}
+
+// BoundingBox is an area between two meridians and two parallels. A box whose MinLongitude is greater than its
+// MaxLongitude crosses the antimeridian.
+type BoundingBox struct {
+	MinLongitude float64 `json:"minLongitude"`
+	MinLatitude  float64 `json:"minLatitude"`
+	MaxLongitude float64 `json:"maxLongitude"`
+	MaxLatitude  float64 `json:"maxLatitude"`
+}
+
+// Duration is a time.Duration that reads and writes as a Go duration string such as "15m" or "24h".
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", text, err)
+	}
+
+	*d = Duration(parsed)
+
+	return nil
+}
+
+type AggregateFunction string
+
+const (
+	AggregateAvg    AggregateFunction = "avg"
+	AggregateMin    AggregateFunction = "min"
+	AggregateMax    AggregateFunction = "max"
+	AggregateSum    AggregateFunction = "sum"
+	AggregateCount  AggregateFunction = "count"
+	AggregateFirst  AggregateFunction = "first"
+	AggregateLast   AggregateFunction = "last"
+	AggregateStddev AggregateFunction = "stddev"
+)
+
+type AggregationQuery struct {
+	// SensorName selects a single sensor, which must also carry all Tags. Otherwise the readings of every sensor
+	// carrying all Tags are combined.
+	SensorName  string              `json:"sensorName"`
+	Tags        []string            `json:"tags"`
+	StartTime   time.Time           `json:"startTime"`
+	EndTime     time.Time           `json:"endTime"`
+	BucketWidth Duration            `json:"bucketWidth" swaggertype:"string" example:"1h"`
+	Functions   []AggregateFunction `json:"functions"`
+	// GoodOnly aggregates only good readings. The continuous aggregates include every reading, so these
+	// queries always read the raw readings.
+	GoodOnly bool `json:"goodOnly"`
+}
+
+// AggregateBucket holds the aggregates of the readings taken in [Time, Time + bucket width). Aggregates that
+// are undefined for the bucket, such as the stddev of a single reading, are left out.
+type AggregateBucket struct {
+	Time   time.Time                     `json:"time"`
+	Values map[AggregateFunction]float64 `json:"values"`
+}
+
+type RetentionScope string
+
+const (
+	RetentionScopeGlobal RetentionScope = "global"
+	RetentionScopeTag    RetentionScope = "tag"
+	RetentionScopeSensor RetentionScope = "sensor"
+)
+
+// RetentionPolicy keeps readings for MaxAge. Target is the tag or sensor name the policy applies to and is
+// empty for the global policy.
+type RetentionPolicy struct {
+	Scope  RetentionScope `json:"scope"`
+	Target string         `json:"target,omitempty"`
+	MaxAge Duration       `json:"maxAge" swaggertype:"string" example:"720h"`
+}
+
+type DeleteRetentionPolicyRequest struct {
+	Scope  RetentionScope `json:"scope"`
+	Target string         `json:"target"`
+}
+
+type ApplyRetentionRequest struct {
+	// DryRun reports what would be removed without removing it.
+	DryRun bool `json:"dryRun"`
+}
+
+type SensorRetention struct {
+	SensorName      string    `json:"sensorName"`
+	MaxAge          Duration  `json:"maxAge" swaggertype:"string" example:"720h"`
+	Cutoff          time.Time `json:"cutoff"`
+	ReadingsDeleted int64     `json:"readingsDeleted"`
+}
+
+// RetentionReport describes one pass of the retention policies. Sensors lists only the sensors that had
+// expired readings.
+type RetentionReport struct {
+	DryRun          bool              `json:"dryRun"`
+	DroppedChunks   []string          `json:"droppedChunks"`
+	Sensors         []SensorRetention `json:"sensors"`
+	ReadingsDeleted int64             `json:"readingsDeleted"`
+}
+
+// CompressionSettings describes TimescaleDB native compression of the readings hypertable. Compressed
+// chunks are segmented by sensor name and ordered by time.
+type CompressionSettings struct {
+	Enabled   bool     `json:"enabled"`
+	SegmentBy []string `json:"segmentBy"`
+	OrderBy   []string `json:"orderBy"`
+	// CompressAfter is the age at which the compression policy compresses a chunk. It is zero when no policy
+	// is scheduled.
+	CompressAfter Duration `json:"compressAfter" swaggertype:"string" example:"168h"`
+}
+
+type SetCompressionRequest struct {
+	Enabled       bool     `json:"enabled"`
+	CompressAfter Duration `json:"compressAfter" swaggertype:"string" example:"168h"`
+}
+
+type ChunkCompression struct {
+	Chunk      string    `json:"chunk"`
+	RangeStart time.Time `json:"rangeStart"`
+	RangeEnd   time.Time `json:"rangeEnd"`
+	Compressed bool      `json:"compressed"`
+	// BytesBeforeCompression, BytesAfterCompression and Ratio are only set for compressed chunks.
+	BytesBeforeCompression int64   `json:"bytesBeforeCompression,omitempty"`
+	BytesAfterCompression  int64   `json:"bytesAfterCompression,omitempty"`
+	Ratio                  float64 `json:"ratio,omitempty"`
+}
+
+// FillStrategy chooses the value of a resampled point that has no readings.
+type FillStrategy string
+
+const (
+	// FillNull leaves the value of the point empty.
+	FillNull FillStrategy = "null"
+	// FillLOCF carries the last observed value forward.
+	FillLOCF FillStrategy = "locf"
+	// FillLinear interpolates linearly between the neighbouring values.
+	FillLinear FillStrategy = "linear"
+	// FillConstant uses ResampleQuery.FillValue.
+	FillConstant FillStrategy = "constant"
+)
+
+// ResampleQuery aligns the readings of a sensor in [StartTime, EndTime) to points every Interval. Each point
+// holds the average of the readings in [Time, Time + Interval).
+type ResampleQuery struct {
+	SensorName string       `json:"sensorName"`
+	StartTime  time.Time    `json:"startTime"`
+	EndTime    time.Time    `json:"endTime"`
+	Interval   Duration     `json:"interval" swaggertype:"string" example:"5m"`
+	Fill       FillStrategy `json:"fill"`
+	FillValue  *float64     `json:"fillValue,omitempty"`
+	GoodOnly   bool         `json:"goodOnly"`
+}
+
+type ResampledReading struct {
+	SensorName string    `json:"sensorName"`
+	Time       time.Time `json:"time"`
+	// Value is null when the point has no readings and the fill strategy is null, or nothing to fill from.
+	Value *float64 `json:"value"`
+	// Synthesized marks points that had no readings and were filled in.
+	Synthesized bool `json:"synthesized"`
+}
+
+// SeriesLayout chooses how the readings of a SeriesQuery are returned.
+type SeriesLayout string
+
+const (
+	// SeriesLayoutGrouped returns one series of points per sensor.
+	SeriesLayoutGrouped SeriesLayout = "grouped"
+	// SeriesLayoutWide returns one row per timestamp with a column per sensor.
+	SeriesLayoutWide SeriesLayout = "wide"
+)
+
+// SeriesQuery selects the readings taken between StartTime and EndTime (inclusive) of the sensors named in
+// SensorNames and/or carrying the tags in AnyTags and AllTags.
+type SeriesQuery struct {
+	SensorNames []string     `json:"sensorNames"`
+	AnyTags     []string     `json:"anyTags"`
+	AllTags     []string     `json:"allTags"`
+	StartTime   time.Time    `json:"startTime"`
+	EndTime     time.Time    `json:"endTime"`
+	Layout      SeriesLayout `json:"layout"`
+	GoodOnly    bool         `json:"goodOnly"`
+}
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatNDJSON  ExportFormat = "ndjson"
+	ExportFormatParquet ExportFormat = "parquet"
+)
+
+// ExportQuery selects the readings taken between StartTime and EndTime (inclusive) of the sensors named in
+// SensorNames and/or carrying the tags in AnyTags and AllTags. Format is only used by the HTTP export, where it
+// overrides the Accept header.
+type ExportQuery struct {
+	SensorNames []string     `json:"sensorNames"`
+	AnyTags     []string     `json:"anyTags"`
+	AllTags     []string     `json:"allTags"`
+	StartTime   time.Time    `json:"startTime"`
+	EndTime     time.Time    `json:"endTime"`
+	Order       SortOrder    `json:"order"`
+	Format      ExportFormat `json:"format"`
+	GoodOnly    bool         `json:"goodOnly"`
+	// AfterSensorName, when set, starts the selection strictly after the reading of that sensor at StartTime in
+	// ascending order, rather than at StartTime. Replays use it to resume after the last reading sent.
+	AfterSensorName string `json:"-" mapstructure:"-"`
+}
+
+type SeriesPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+type Series struct {
+	SensorName string        `json:"sensorName"`
+	Unit       string        `json:"unit,omitempty"`
+	Points     []SeriesPoint `json:"points"`
+}
+
+// WideRow holds the values of every column at Time, null for sensors without a reading at that time.
+type WideRow struct {
+	Time   time.Time  `json:"time"`
+	Values []*float64 `json:"values"`
+}
+
+// SeriesResponse holds Series for the grouped layout, or Columns (sensor names) and Rows for the wide layout.
+type SeriesResponse struct {
+	Layout  SeriesLayout `json:"layout"`
+	Series  []*Series    `json:"series,omitempty"`
+	Columns []string     `json:"columns,omitempty"`
+	Units   []string     `json:"units,omitempty"`
+	Rows    []*WideRow   `json:"rows,omitempty"`
 }
@@ -0,0 +1,61 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// Database wraps a db.Database so every reading it stores is published to a Hub, whichever API it came in on.
+type Database struct {
+	db.Database
+	hub *Hub
+}
+
+func NewDatabase(database db.Database, hub *Hub) *Database {
+	return &Database{Database: database, hub: hub}
+}
+
+func (d *Database) CreateSensorReading(ctx context.Context,
+	reading *models.SensorReading) (*models.SensorReading, error) {
+	reading, err := d.Database.CreateSensorReading(ctx, reading)
+	if err != nil {
+		return nil, err
+	}
+
+	d.hub.Publish(ctx, reading)
+
+	return reading, nil
+}
+
+func (d *Database) CreateSensorReadings(ctx context.Context,
+	readings []*models.SensorReading, atomic bool) ([]error, error) {
+	itemErrs, err := d.Database.CreateSensorReadings(ctx, readings, atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	written := make([]*models.SensorReading, 0, len(readings))
+	for i, itemErr := range itemErrs {
+		if itemErr == nil {
+			written = append(written, readings[i])
+		}
+	}
+
+	d.hub.Publish(ctx, written...)
+
+	return itemErrs, nil
+}
+
+func (d *Database) UpdateSensor(ctx context.Context, updatedSensor *models.Sensor) (int64, error) {
+	defer d.hub.Invalidate(updatedSensor.Name)
+
+	return d.Database.UpdateSensor(ctx, updatedSensor)
+}
+
+func (d *Database) DeleteSensor(ctx context.Context, sensorName string, mode models.DeleteMode) (int64, error) {
+	defer d.hub.Invalidate(sensorName)
+
+	return d.Database.DeleteSensor(ctx, sensorName, mode)
+}
@@ -0,0 +1,64 @@
+package pubsub
+
+import (
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// Filter selects readings by sensor. Every populated field must match; an empty filter matches everything.
+type Filter struct {
+	SensorNames []string
+	// Tags the sensor must all carry.
+	Tags        []string
+	BoundingBox *models.BoundingBox
+}
+
+func (f Filter) needsSensor() bool {
+	return len(f.Tags) > 0 || f.BoundingBox != nil
+}
+
+// Matches reports whether reading, taken by sensor, passes the filter. sensor may be nil when the filter
+// does not look at tags or location.
+func (f Filter) Matches(reading *models.SensorReading, sensor *models.Sensor) bool {
+	if len(f.SensorNames) > 0 && !contains(f.SensorNames, reading.SensorName) {
+		return false
+	}
+
+	if !f.needsSensor() {
+		return true
+	}
+
+	if sensor == nil {
+		return false
+	}
+
+	for _, tag := range f.Tags {
+		if !contains(sensor.Tags, tag) {
+			return false
+		}
+	}
+
+	return f.BoundingBox == nil || boxContains(f.BoundingBox, sensor.Location)
+}
+
+func boxContains(box *models.BoundingBox, location models.Location) bool {
+	if location.Latitude < box.MinLatitude || location.Latitude > box.MaxLatitude {
+		return false
+	}
+
+	if box.MinLongitude <= box.MaxLongitude {
+		return location.Longitude >= box.MinLongitude && location.Longitude <= box.MaxLongitude
+	}
+
+	// the box wraps around the antimeridian
+	return location.Longitude >= box.MinLongitude || location.Longitude <= box.MaxLongitude
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
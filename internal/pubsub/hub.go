@@ -0,0 +1,217 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+var (
+	ErrSlowConsumer = errors.New("subscriber could not keep up with incoming readings")
+	// ErrHubClosed ends every subscription when the hub is closed, e.g. because the server is shutting down.
+	ErrHubClosed = errors.New("server is shutting down")
+)
+
+// SlowConsumerPolicy decides what happens when a subscriber's buffer is full.
+type SlowConsumerPolicy int
+
+const (
+	// DropReadings discards readings the subscriber has no room for and counts them.
+	DropReadings SlowConsumerPolicy = iota
+	// Disconnect ends the subscription with ErrSlowConsumer.
+	Disconnect
+)
+
+// SensorLookup resolves the sensor a reading belongs to. db.Database satisfies it.
+type SensorLookup interface {
+	GetSensor(ctx context.Context, sensorName string) (*models.Sensor, error)
+}
+
+// Hub fans newly stored readings out to in-process subscribers.
+type Hub struct {
+	sensors SensorLookup
+
+	mu          sync.RWMutex
+	subscribers map[*Subscription]struct{}
+	closed      bool
+
+	cacheMu sync.Mutex
+	cache   map[string]*models.Sensor
+}
+
+func NewHub(sensors SensorLookup) *Hub {
+	return &Hub{
+		sensors:     sensors,
+		subscribers: make(map[*Subscription]struct{}),
+		cache:       make(map[string]*models.Sensor),
+	}
+}
+
+// Subscription receives the readings published to a Hub that match its filter.
+type Subscription struct {
+	hub      *Hub
+	filter   Filter
+	policy   SlowConsumerPolicy
+	readings chan *models.SensorReading
+	done     chan struct{}
+	once     sync.Once
+	err      error
+	dropped  atomic.Uint64
+}
+
+// Subscribe registers a subscriber that buffers up to bufferSize readings. Once the hub is closed the
+// subscription it returns has already ended with ErrHubClosed.
+func (h *Hub) Subscribe(filter Filter, policy SlowConsumerPolicy, bufferSize int) *Subscription {
+	sub := &Subscription{
+		hub:      h,
+		filter:   filter,
+		policy:   policy,
+		readings: make(chan *models.SensorReading, bufferSize),
+		done:     make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	closed := h.closed
+	if !closed {
+		h.subscribers[sub] = struct{}{}
+	}
+	h.mu.Unlock()
+
+	if closed {
+		sub.close(ErrHubClosed)
+	}
+
+	return sub
+}
+
+// Close ends every subscription with ErrHubClosed, so that streams waiting on readings return and the servers
+// can shut down, and refuses new subscriptions.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	h.closed = true
+	subs := make([]*Subscription, 0, len(h.subscribers))
+	for sub := range h.subscribers {
+		subs = append(subs, sub)
+	}
+	h.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.close(ErrHubClosed)
+	}
+}
+
+// Publish delivers readings to every matching subscriber without blocking on any of them.
+func (h *Hub) Publish(ctx context.Context, readings ...*models.SensorReading) {
+	h.mu.RLock()
+	subs := make([]*Subscription, 0, len(h.subscribers))
+	for sub := range h.subscribers {
+		subs = append(subs, sub)
+	}
+	h.mu.RUnlock()
+
+	if len(subs) == 0 {
+		return
+	}
+
+	for _, reading := range readings {
+		var sensor *models.Sensor
+
+		for _, sub := range subs {
+			if sub.filter.needsSensor() && sensor == nil {
+				sensor = h.sensor(ctx, reading.SensorName)
+			}
+
+			if sub.filter.Matches(reading, sensor) {
+				sub.deliver(reading)
+			}
+		}
+	}
+}
+
+// Invalidate forgets what the hub knows about a sensor after it has been changed or removed.
+func (h *Hub) Invalidate(sensorName string) {
+	h.cacheMu.Lock()
+	delete(h.cache, sensorName)
+	h.cacheMu.Unlock()
+}
+
+func (h *Hub) sensor(ctx context.Context, name string) *models.Sensor {
+	h.cacheMu.Lock()
+	sensor, ok := h.cache[name]
+	h.cacheMu.Unlock()
+
+	if ok {
+		return sensor
+	}
+
+	sensor, err := h.sensors.GetSensor(ctx, name)
+	if err != nil {
+		return nil
+	}
+
+	h.cacheMu.Lock()
+	h.cache[name] = sensor
+	h.cacheMu.Unlock()
+
+	return sensor
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	delete(h.subscribers, sub)
+	h.mu.Unlock()
+}
+
+func (s *Subscription) deliver(reading *models.SensorReading) {
+	select {
+	case <-s.done:
+	case s.readings <- reading:
+	default:
+		if s.policy == Disconnect {
+			s.close(ErrSlowConsumer)
+		} else {
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// Readings returns the channel matching readings are delivered on.
+func (s *Subscription) Readings() <-chan *models.SensorReading {
+	return s.readings
+}
+
+// Done is closed once the subscription has ended, see Err for the reason.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns why the subscription ended, or nil while it is active or after Close.
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Dropped returns how many readings were discarded because the subscriber fell behind.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.close(nil)
+}
+
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+		s.hub.unsubscribe(s)
+	})
+}
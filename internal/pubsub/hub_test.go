@@ -0,0 +1,103 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/models"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
+)
+
+// sensorLookup is an in-memory pubsub.SensorLookup
+type sensorLookup map[string]*models.Sensor
+
+func (l sensorLookup) GetSensor(_ context.Context, name string) (*models.Sensor, error) {
+	if sensor, ok := l[name]; ok {
+		return sensor, nil
+	}
+
+	return nil, db.ErrSensorNotFound
+}
+
+var sensors = sensorLookup{
+	"indoor": {
+		Name:     "indoor",
+		Location: models.Location{Longitude: 10, Latitude: 50},
+		Tags:     []string{"temperature", "indoor"},
+	},
+	"outdoor": {
+		Name:     "outdoor",
+		Location: models.Location{Longitude: 170, Latitude: -40},
+		Tags:     []string{"temperature", "outdoor"},
+	},
+}
+
+func TestHubFiltersReadings(t *testing.T) {
+	hub := pubsub.NewHub(sensors)
+
+	byName := hub.Subscribe(pubsub.Filter{SensorNames: []string{"outdoor"}}, pubsub.DropReadings, 10)
+	byTags := hub.Subscribe(pubsub.Filter{Tags: []string{"temperature", "indoor"}}, pubsub.DropReadings, 10)
+	byBox := hub.Subscribe(pubsub.Filter{BoundingBox: &models.BoundingBox{
+		// crosses the antimeridian
+		MinLongitude: 160, MinLatitude: -50, MaxLongitude: -170, MaxLatitude: -30,
+	}}, pubsub.DropReadings, 10)
+
+	indoor := &models.SensorReading{SensorName: "indoor", Value: 1}
+	outdoor := &models.SensorReading{SensorName: "outdoor", Value: 2}
+	hub.Publish(context.Background(), indoor, outdoor)
+
+	require.Equal(t, outdoor, <-byName.Readings())
+	require.Equal(t, indoor, <-byTags.Readings())
+	require.Equal(t, outdoor, <-byBox.Readings())
+
+	for _, sub := range []*pubsub.Subscription{byName, byTags, byBox} {
+		require.Empty(t, sub.Readings())
+	}
+}
+
+func TestHubSlowConsumers(t *testing.T) {
+	hub := pubsub.NewHub(sensors)
+
+	dropping := hub.Subscribe(pubsub.Filter{}, pubsub.DropReadings, 1)
+	disconnecting := hub.Subscribe(pubsub.Filter{}, pubsub.Disconnect, 1)
+
+	hub.Publish(context.Background(),
+		&models.SensorReading{SensorName: "indoor", Value: 1},
+		&models.SensorReading{SensorName: "indoor", Value: 2},
+		&models.SensorReading{SensorName: "indoor", Value: 3})
+
+	// the dropping subscriber keeps the first reading and counts the rest
+	require.Equal(t, uint64(2), dropping.Dropped())
+	require.NoError(t, dropping.Err())
+	require.Equal(t, 1.0, (<-dropping.Readings()).Value)
+
+	// the disconnecting subscriber is closed as soon as it falls behind
+	<-disconnecting.Done()
+	require.ErrorIs(t, disconnecting.Err(), pubsub.ErrSlowConsumer)
+
+	// closed subscriptions receive nothing further
+	dropping.Close()
+	hub.Publish(context.Background(), &models.SensorReading{SensorName: "indoor", Value: 4})
+	require.Empty(t, dropping.Readings())
+	require.NoError(t, dropping.Err())
+}
+
+func TestHubClose(t *testing.T) {
+	hub := pubsub.NewHub(sensors)
+
+	sub := hub.Subscribe(pubsub.Filter{}, pubsub.DropReadings, 10)
+
+	hub.Close()
+
+	// subscribers waiting on readings are released
+	<-sub.Done()
+	require.ErrorIs(t, sub.Err(), pubsub.ErrHubClosed)
+
+	// and nobody can subscribe any more
+	late := hub.Subscribe(pubsub.Filter{}, pubsub.DropReadings, 10)
+	<-late.Done()
+	require.ErrorIs(t, late.Err(), pubsub.ErrHubClosed)
+}
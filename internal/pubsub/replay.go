@@ -0,0 +1,287 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const (
+	// replayBatchSize is how many readings a replay fetches from the database at a time.
+	replayBatchSize = 500
+	// replayQueueSize caps how many live readings are held back while stored readings are replayed.
+	replayQueueSize = 10000
+)
+
+// errSubscriptionEnded stops a replay once its subscription has ended.
+var errSubscriptionEnded = errors.New("subscription ended")
+
+// Position is where a reading falls in the order readings are replayed in: by time, then by sensor name.
+type Position struct {
+	Time       time.Time
+	SensorName string
+}
+
+// PositionOf returns the position of reading.
+func PositionOf(reading *models.SensorReading) Position {
+	return Position{Time: reading.Time, SensorName: reading.SensorName}
+}
+
+// Before reports whether p comes before other.
+func (p Position) Before(other Position) bool {
+	if !p.Time.Equal(other.Time) {
+		return p.Time.Before(other.Time)
+	}
+
+	return p.SensorName < other.SensorName
+}
+
+// Replay sends the stored readings matching sub's filter that come after position after and were taken no
+// later than until, oldest first, so a subscriber can resume from where it left off, and then the live readings
+// sub received meanwhile. An after without a sensor name includes the readings taken at its time.
+//
+// The readings are streamed from the database in batches rather than loaded at once, so a long window costs time
+// but not memory. While they are sent, live readings are taken off sub into a queue of up to replayQueueSize, so
+// that the subscription's buffer does not fill up, and those the replay also sent are left out of it. Replay
+// returns early without an error once sub has ended; the caller carries on reading sub afterwards.
+func Replay(ctx context.Context, database db.Database, sub *Subscription, after Position, until time.Time,
+	send func(*models.SensorReading) error,
+) error {
+	queue := newReplayQueue(sub)
+
+	err := replayStored(ctx, database, sub.filter, after, until, func(reading *models.SensorReading) error {
+		select {
+		case <-sub.done:
+			return errSubscriptionEnded
+		default:
+		}
+
+		queue.replayed(reading)
+
+		return send(reading)
+	})
+
+	live := queue.stop()
+
+	if errors.Is(err, errSubscriptionEnded) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, reading := range live {
+		if err = send(reading); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayStored sends the stored readings matching filter that come after position after and were taken no later
+// than until, oldest first.
+func replayStored(ctx context.Context, database db.Database, filter Filter, after Position, until time.Time,
+	send func(*models.SensorReading) error,
+) error {
+	query := models.ExportQuery{
+		SensorNames:     filter.SensorNames,
+		AllTags:         filter.Tags,
+		StartTime:       after.Time,
+		EndTime:         until,
+		Order:           models.SortOrderAsc,
+		AfterSensorName: after.SensorName,
+	}
+
+	// the database cannot select by bounding box, so the box is resolved to the sensors inside it up front
+	if filter.BoundingBox != nil {
+		names, err := replaySensors(ctx, database, filter)
+		if err != nil {
+			return err
+		}
+
+		if len(names) == 0 {
+			return nil
+		}
+
+		query.SensorNames = names
+	}
+
+	return database.ExportSensorReadings(ctx, query, replayBatchSize, func(readings []*models.SensorReading) error {
+		for _, reading := range readings {
+			if err := send(reading); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// replayQueue holds the live readings a subscription receives while stored readings are replayed.
+type replayQueue struct {
+	sub     *Subscription
+	quit    chan struct{}
+	stopped chan struct{}
+
+	mu       sync.Mutex
+	readings []*models.SensorReading
+	// queued tells whether each queued reading has also been replayed.
+	queued map[positionKey]bool
+	// recent holds the last replayQueueSize readings replayed before they were queued, in case they are queued
+	// later; ring holds them in the order they were replayed.
+	recent map[positionKey]struct{}
+	ring   []positionKey
+}
+
+// positionKey identifies a reading whether it was read from the database or published.
+type positionKey struct {
+	unixNano   int64
+	sensorName string
+}
+
+func keyOf(reading *models.SensorReading) positionKey {
+	return positionKey{unixNano: reading.Time.UnixNano(), sensorName: reading.SensorName}
+}
+
+// newReplayQueue starts taking the live readings off sub until stop is called.
+func newReplayQueue(sub *Subscription) *replayQueue {
+	q := &replayQueue{
+		sub:     sub,
+		quit:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		queued:  make(map[positionKey]bool),
+		recent:  make(map[positionKey]struct{}),
+	}
+
+	go func() {
+		defer close(q.stopped)
+
+		for {
+			select {
+			case reading := <-sub.readings:
+				q.add(reading)
+			case <-sub.done:
+				return
+			case <-q.quit:
+				return
+			}
+		}
+	}()
+
+	return q
+}
+
+// add queues reading unless it has just been replayed, applying the subscription's slow consumer policy once the
+// queue is full.
+func (q *replayQueue) add(reading *models.SensorReading) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.recent[keyOf(reading)]; ok {
+		return
+	}
+
+	if len(q.readings) >= replayQueueSize {
+		if q.sub.policy == Disconnect {
+			q.sub.close(ErrSlowConsumer)
+		} else {
+			q.sub.dropped.Add(1)
+		}
+
+		return
+	}
+
+	q.readings = append(q.readings, reading)
+	q.queued[keyOf(reading)] = false
+}
+
+// replayed notes that reading has been replayed, so that it is not sent again from the queue.
+func (q *replayQueue) replayed(reading *models.SensorReading) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	key := keyOf(reading)
+	if _, ok := q.queued[key]; ok {
+		q.queued[key] = true
+		return
+	}
+
+	if len(q.ring) == replayQueueSize {
+		delete(q.recent, q.ring[0])
+		q.ring = q.ring[1:]
+	}
+
+	q.recent[key] = struct{}{}
+	q.ring = append(q.ring, key)
+}
+
+// stop stops taking readings off the subscription and returns the queued readings that were not replayed. The
+// readings still buffered by the subscription are queued first, so that none the replay sent is left there.
+func (q *replayQueue) stop() []*models.SensorReading {
+	close(q.quit)
+	<-q.stopped
+
+	for drained := false; !drained; {
+		select {
+		case reading := <-q.sub.readings:
+			q.add(reading)
+		default:
+			drained = true
+		}
+	}
+
+	live := make([]*models.SensorReading, 0, len(q.readings))
+	for _, reading := range q.readings {
+		if !q.queued[keyOf(reading)] {
+			live = append(live, reading)
+		}
+	}
+
+	return live
+}
+
+// replaySensors resolves filter to the names of the sensors it matches.
+func replaySensors(ctx context.Context, database db.Database, filter Filter) ([]string, error) {
+	var names []string
+
+	if len(filter.SensorNames) > 0 {
+		for _, name := range filter.SensorNames {
+			sensor, err := database.GetSensor(ctx, name)
+			if errors.Is(err, db.ErrSensorNotFound) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			if filter.Matches(&models.SensorReading{SensorName: name}, sensor) {
+				names = append(names, name)
+			}
+		}
+
+		return names, nil
+	}
+
+	query := models.ListSensorsQuery{AllTags: filter.Tags, PageSize: 1000}
+	for {
+		page, err := database.ListSensors(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sensor := range page.Sensors {
+			if filter.Matches(&models.SensorReading{SensorName: sensor.Name}, sensor) {
+				names = append(names, sensor.Name)
+			}
+		}
+
+		if page.NextPageToken == "" {
+			return names, nil
+		}
+
+		query.PageToken = page.NextPageToken
+	}
+}
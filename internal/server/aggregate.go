@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const maxAggregateBuckets = 10000
+
+var aggregateFunctions = map[models.AggregateFunction]bool{
+	models.AggregateAvg:    true,
+	models.AggregateMin:    true,
+	models.AggregateMax:    true,
+	models.AggregateSum:    true,
+	models.AggregateCount:  true,
+	models.AggregateFirst:  true,
+	models.AggregateLast:   true,
+	models.AggregateStddev: true,
+}
+
+// validateAggregationQuery checks query and fills in its defaults. Queries that would return more than
+// maxAggregateBuckets buckets are rejected so a small bucket width cannot turn into an unbounded response.
+func validateAggregationQuery(query *models.AggregationQuery) error {
+	if (query.SensorName == "" && len(query.Tags) == 0) || query.StartTime.IsZero() || query.EndTime.IsZero() {
+		return errMissingFields
+	}
+
+	if !query.EndTime.After(query.StartTime) {
+		return errors.New("endTime must be after startTime")
+	}
+
+	width := time.Duration(query.BucketWidth)
+	if width < time.Microsecond {
+		return errors.New("bucketWidth must be at least 1µs")
+	}
+
+	if buckets := query.EndTime.Sub(query.StartTime) / width; buckets > maxAggregateBuckets {
+		return fmt.Errorf("time range spans %d buckets, more than the limit of %d", buckets, maxAggregateBuckets)
+	}
+
+	if len(query.Functions) == 0 {
+		query.Functions = []models.AggregateFunction{models.AggregateAvg}
+	}
+
+	for _, function := range query.Functions {
+		if !aggregateFunctions[function] {
+			return fmt.Errorf("unknown aggregate function %q", function)
+		}
+	}
+
+	return nil
+}
+
+// validateResampleQuery checks query and fills in its defaults, bounding the number of points like
+// validateAggregationQuery bounds buckets.
+func validateResampleQuery(query *models.ResampleQuery) error {
+	if query.SensorName == "" || query.StartTime.IsZero() || query.EndTime.IsZero() {
+		return errMissingFields
+	}
+
+	if !query.EndTime.After(query.StartTime) {
+		return errors.New("endTime must be after startTime")
+	}
+
+	interval := time.Duration(query.Interval)
+	if interval < time.Microsecond {
+		return errors.New("interval must be at least 1µs")
+	}
+
+	if points := query.EndTime.Sub(query.StartTime) / interval; points > maxAggregateBuckets {
+		return fmt.Errorf("time range spans %d points, more than the limit of %d", points, maxAggregateBuckets)
+	}
+
+	switch query.Fill {
+	case "":
+		query.Fill = models.FillNull
+	case models.FillNull, models.FillLOCF, models.FillLinear:
+	case models.FillConstant:
+		if query.FillValue == nil {
+			return errors.New("constant fill needs a fillValue")
+		}
+	default:
+		return fmt.Errorf("unknown fill strategy %q", query.Fill)
+	}
+
+	return nil
+}
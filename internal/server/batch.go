@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const maxBatchSize = 10000
+
+var errMissingFields = errors.New("missing required fields")
+
+// createSensorReadings validates a batch, hands the valid readings to the database and reports the outcome of
+// every reading in the order they were sent.
+func createSensorReadings(ctx context.Context, database db.Database, window ReadingTimeWindow,
+	req models.BatchSensorReadingsRequest,
+) (*models.BatchSensorReadingsResponse, error) {
+	if len(req.Readings) == 0 {
+		return nil, errMissingFields
+	}
+
+	if len(req.Readings) > maxBatchSize {
+		return nil, fmt.Errorf("batch of %d readings exceeds the limit of %d", len(req.Readings), maxBatchSize)
+	}
+
+	itemErrs := make([]error, len(req.Readings))
+	valid := make([]*models.SensorReading, 0, len(req.Readings))
+	validIndexes := make([]int, 0, len(req.Readings))
+	now := time.Now()
+
+	for i, reading := range req.Readings {
+		if reading == nil || reading.SensorName == "" || reading.Value == 0.0 {
+			itemErrs[i] = errMissingFields
+		} else if err := window.Check(reading.Time, now); err != nil {
+			itemErrs[i] = err
+		} else if err = validateQuality(reading.Quality); err != nil {
+			itemErrs[i] = err
+		} else {
+			valid = append(valid, reading)
+			validIndexes = append(validIndexes, i)
+		}
+	}
+
+	if req.Atomic && len(valid) < len(req.Readings) {
+		valid, validIndexes = nil, nil
+
+		for i, err := range itemErrs {
+			if err == nil {
+				itemErrs[i] = db.ErrBatchAborted
+			}
+		}
+	}
+
+	if len(valid) > 0 {
+		dbErrs, err := database.CreateSensorReadings(ctx, valid, req.Atomic)
+		if err != nil {
+			return nil, err
+		}
+
+		for j, err := range dbErrs {
+			itemErrs[validIndexes[j]] = err
+		}
+	}
+
+	res := &models.BatchSensorReadingsResponse{Results: make([]models.BatchItemResult, len(req.Readings))}
+
+	for i, err := range itemErrs {
+		res.Results[i].Index = i
+		if err != nil {
+			res.Results[i].Error = err.Error()
+			res.Rejected++
+		} else {
+			res.Results[i].Reading = req.Readings[i]
+			res.Accepted++
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,15 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+func validateSetCompressionRequest(req models.SetCompressionRequest) error {
+	if req.Enabled && req.CompressAfter <= 0 {
+		return errors.New("compressAfter must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
+)
+
+const sseKeepAliveInterval = 15 * time.Second
+
+// @Summary Stream new sensor readings
+// @Description Push newly stored readings as Server-Sent Events ("reading" events whose id is the time and
+// @Description escaped sensor name of the latest reading sent, separated by "|"). When since or a Last-Event-ID
+// @Description header is given, the stored readings taken from since, or after the Last-Event-ID, are replayed
+// @Description first.
+// @Tags sensor_readings
+// @Produce  text/event-stream
+// @Param sensorName query []string false "Only readings of these sensors" collectionFormat(multi)
+// @Param tag query []string false "Only readings of sensors carrying all of these tags" collectionFormat(multi)
+// @Param bbox query string false "Only readings of sensors inside minLongitude,minLatitude,maxLongitude,maxLatitude"
+// @Param since query string false "Replay readings taken since this RFC 3339 time"
+// @Param slowConsumer query string false "What to do when the client falls behind" Enums(drop, disconnect)
+// @Success 200 {string} string "Event stream"
+// @Router /sensor_readings/stream [get]
+func (s *SensorSphere) HandleStreamSensorReadings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.HttpTracer.Start(r.Context(), "HandleStreamSensorReadings")
+	defer span.End()
+
+	if s.hub == nil {
+		http.Error(w, "live readings are not enabled", http.StatusNotImplemented)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	filter, policy, resume, err := parseStreamParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// subscribe before replaying so nothing stored in the meantime is missed
+	sub := s.hub.Subscribe(filter, policy, watchBufferSize)
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// Live readings may have been taken before ones already sent, so the event id is the latest position sent
+	// rather than that of the reading: resuming after it never sends a reading twice.
+	var latest pubsub.Position
+	send := func(reading *models.SensorReading) error {
+		data, err := json.Marshal(reading)
+		if err != nil {
+			return err
+		}
+
+		if position := pubsub.PositionOf(reading); latest.Before(position) {
+			latest = position
+		}
+
+		_, err = fmt.Fprintf(w, "id: %s\nevent: reading\ndata: %s\n\n", formatEventID(latest), data)
+		return err
+	}
+
+	if !resume.Time.IsZero() {
+		if err = pubsub.Replay(ctx, s.database, sub, resume, time.Now(), send); err != nil {
+			zap.L().Sugar().Error(err, r)
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case reading := <-sub.Readings():
+			err = send(reading)
+		case <-keepAlive.C:
+			_, err = fmt.Fprint(w, ": keep-alive\n\n")
+		case <-sub.Done():
+			data, _ := json.Marshal(map[string]string{"error": sub.Err().Error()})
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+			flusher.Flush()
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// parseStreamParams reads the filter, slow consumer policy and replay start of a stream request.
+func parseStreamParams(r *http.Request) (pubsub.Filter, pubsub.SlowConsumerPolicy, pubsub.Position, error) {
+	query := r.URL.Query()
+	filter := pubsub.Filter{SensorNames: query["sensorName"], Tags: query["tag"]}
+
+	if bbox := query.Get("bbox"); bbox != "" {
+		box, err := parseBoundingBox(bbox)
+		if err != nil {
+			return filter, 0, pubsub.Position{}, err
+		}
+		filter.BoundingBox = box
+	}
+
+	policy := pubsub.DropReadings
+	switch query.Get("slowConsumer") {
+	case "", "drop":
+	case "disconnect":
+		policy = pubsub.Disconnect
+	default:
+		return filter, 0, pubsub.Position{}, fmt.Errorf("invalid slowConsumer %q", query.Get("slowConsumer"))
+	}
+
+	// browsers resend the id of the last event they saw when they reconnect
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		resume, err := parseEventID(lastEventID)
+		if err != nil {
+			return filter, 0, pubsub.Position{}, fmt.Errorf("invalid Last-Event-ID: %w", err)
+		}
+
+		return filter, policy, resume, nil
+	}
+
+	var since time.Time
+	if sinceParam := query.Get("since"); sinceParam != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339Nano, sinceParam); err != nil {
+			return filter, 0, pubsub.Position{}, fmt.Errorf("invalid since: %w", err)
+		}
+	}
+
+	return filter, policy, pubsub.Position{Time: since}, nil
+}
+
+// formatEventID writes position as an event id: its RFC 3339 time and path-escaped sensor name, separated by "|".
+func formatEventID(position pubsub.Position) string {
+	return position.Time.UTC().Format(time.RFC3339Nano) + "|" + url.PathEscape(position.SensorName)
+}
+
+// parseEventID reads an event id written by formatEventID.
+func parseEventID(id string) (pubsub.Position, error) {
+	timePart, namePart, ok := strings.Cut(id, "|")
+	if !ok {
+		return pubsub.Position{}, errors.New("expected time|sensorName")
+	}
+
+	eventTime, err := time.Parse(time.RFC3339Nano, timePart)
+	if err != nil {
+		return pubsub.Position{}, err
+	}
+
+	name, err := url.PathUnescape(namePart)
+	if err != nil {
+		return pubsub.Position{}, err
+	}
+
+	return pubsub.Position{Time: eventTime, SensorName: name}, nil
+}
+
+// parseBoundingBox parses "minLongitude,minLatitude,maxLongitude,maxLatitude".
+func parseBoundingBox(bbox string) (*models.BoundingBox, error) {
+	parts := strings.Split(bbox, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("bbox must be minLongitude,minLatitude,maxLongitude,maxLatitude")
+	}
+
+	var coords [4]float64
+	for i, part := range parts {
+		coord, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bbox: %w", err)
+		}
+		coords[i] = coord
+	}
+
+	return &models.BoundingBox{
+		MinLongitude: coords[0],
+		MinLatitude:  coords[1],
+		MaxLongitude: coords[2],
+		MaxLatitude:  coords[3],
+	}, nil
+}
@@ -0,0 +1,333 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"go.uber.org/zap"
+
+	"github.com/koneal2013/sensorsphere/internal/middleware/adaptor"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const (
+	// exportBatchSize is how many readings are fetched from the database cursor and written out at a time.
+	exportBatchSize = 5000
+	// parquetRowGroupSize bounds how many bytes of readings the Parquet writer buffers before writing a row group.
+	parquetRowGroupSize = 64 << 20
+)
+
+// exportContentTypes maps each export format to the media type it is served with.
+var exportContentTypes = map[models.ExportFormat]string{
+	models.ExportFormatCSV:     "text/csv",
+	models.ExportFormatNDJSON:  "application/x-ndjson",
+	models.ExportFormatParquet: "application/vnd.apache.parquet",
+}
+
+// exportAcceptTypes maps the media types a client may ask for in Accept to an export format.
+var exportAcceptTypes = map[string]models.ExportFormat{
+	"text/csv":                       models.ExportFormatCSV,
+	"application/x-ndjson":           models.ExportFormatNDJSON,
+	"application/ndjson":             models.ExportFormatNDJSON,
+	"application/jsonl":              models.ExportFormatNDJSON,
+	"application/vnd.apache.parquet": models.ExportFormatParquet,
+	"application/x-parquet":          models.ExportFormatParquet,
+}
+
+// @Summary Export sensor readings
+// @Description Stream the readings between startTime and endTime of the named sensors and/or of the sensors
+// @Description matching the tag filters as CSV, newline-delimited JSON or Parquet, ordered by time. The format
+// @Description parameter overrides the Accept header; CSV is the default. Rows are written as they are read
+// @Description from the database, so exports of any size can be downloaded.
+// @Tags sensor_readings
+// @Produce  text/csv
+// @Produce  application/x-ndjson
+// @Produce  application/vnd.apache.parquet
+// @Param sensorNames query []string false "Sensor names" collectionFormat(multi)
+// @Param anyTags query []string false "Only sensors with at least one of these tags" collectionFormat(multi)
+// @Param allTags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param startTime query string true "Start of the range (inclusive), RFC 3339"
+// @Param endTime query string true "End of the range (inclusive), RFC 3339"
+// @Param order query string false "Sort order by time" Enums(asc, desc)
+// @Param format query string false "Export format, overriding the Accept header" Enums(csv, ndjson, parquet)
+// @Param goodOnly query bool false "Leave out readings whose quality is not good"
+// @Success 200 {file} file "Readings"
+// @Router /sensor_readings/export [get]
+func (s *SensorSphere) HandleExportSensorReadings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.HttpTracer.Start(r.Context(), "HandleExportSensorReadings")
+	defer span.End()
+
+	query, err := adaptor.GenericDecoder[models.ExportQuery](r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = validateExportQuery(&query, r.Header.Get("Accept")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	out := &exportResponseWriter{ResponseWriter: w}
+	exporter := newExporter(query.Format, out)
+
+	w.Header().Set("Content-Type", exportContentTypes[query.Format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="sensor_readings.%s"`, query.Format))
+
+	err = s.database.ExportSensorReadings(ctx, query, exportBatchSize, func(readings []*models.SensorReading) error {
+		if err := exporter.write(readings); err != nil {
+			return err
+		}
+
+		out.flush()
+
+		return nil
+	})
+	if err == nil {
+		err = exporter.close()
+	}
+
+	if err != nil {
+		zap.L().Sugar().Error(err, r)
+
+		// once rows have been sent the status can no longer change, so the client sees a truncated file
+		if !out.written {
+			w.Header().Del("Content-Disposition")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// validateExportQuery checks query and fills in its defaults, negotiating the format from accept when the
+// query does not name one.
+func validateExportQuery(query *models.ExportQuery, accept string) error {
+	if len(query.SensorNames) == 0 && len(query.AnyTags) == 0 && len(query.AllTags) == 0 {
+		return errMissingFields
+	}
+
+	if query.StartTime.IsZero() || query.EndTime.IsZero() {
+		return errMissingFields
+	}
+
+	if query.EndTime.Before(query.StartTime) {
+		return errors.New("endTime must not be before startTime")
+	}
+
+	if query.Order != "" && query.Order != models.SortOrderAsc && query.Order != models.SortOrderDesc {
+		return fmt.Errorf("invalid sort order %q", query.Order)
+	}
+
+	if query.Format == "" {
+		query.Format = negotiateExportFormat(accept)
+	}
+
+	if _, ok := exportContentTypes[query.Format]; !ok {
+		return errors.New("format must be csv, ndjson or parquet")
+	}
+
+	return nil
+}
+
+// negotiateExportFormat returns the first export format listed in an Accept header, or CSV when none is.
+func negotiateExportFormat(accept string) models.ExportFormat {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+
+		if format, ok := exportAcceptTypes[mediaType]; ok {
+			return format
+		}
+	}
+
+	return models.ExportFormatCSV
+}
+
+// exportResponseWriter remembers whether any of the export has been sent, and flushes it to the client after
+// every batch so that nothing piles up in the server's buffers.
+type exportResponseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	w.written = true
+
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *exportResponseWriter) flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok && w.written {
+		flusher.Flush()
+	}
+}
+
+// exporter encodes readings in one of the export formats.
+type exporter interface {
+	write(readings []*models.SensorReading) error
+	// close writes anything still buffered, such as the Parquet footer.
+	close() error
+}
+
+func newExporter(format models.ExportFormat, w io.Writer) exporter {
+	switch format {
+	case models.ExportFormatNDJSON:
+		return &ndjsonExporter{encoder: json.NewEncoder(w)}
+	case models.ExportFormatParquet:
+		return &parquetExporter{writer: goparquet.NewFileWriter(w,
+			goparquet.WithSchemaDefinition(parquetSchema),
+			goparquet.WithCompressionCodec(parquet.CompressionCodec_SNAPPY),
+			goparquet.WithMaxRowGroupSize(parquetRowGroupSize))}
+	default:
+		return &csvExporter{writer: csv.NewWriter(w)}
+	}
+}
+
+var csvHeader = []string{"time", "sensor_name", "value", "unit", "quality", "annotation"}
+
+type csvExporter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvExporter) write(readings []*models.SensorReading) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	for _, reading := range readings {
+		err := e.writer.Write([]string{
+			reading.Time.UTC().Format(time.RFC3339Nano),
+			reading.SensorName,
+			strconv.FormatFloat(reading.Value, 'g', -1, 64),
+			reading.Unit,
+			string(reading.Quality),
+			reading.Annotation,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+func (e *csvExporter) close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+// writeHeader writes the header row once, so that an empty export is still a valid CSV file.
+func (e *csvExporter) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+
+	e.headerWritten = true
+
+	return e.writer.Write(csvHeader)
+}
+
+type ndjsonExporter struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonExporter) write(readings []*models.SensorReading) error {
+	for _, reading := range readings {
+		if err := e.encoder.Encode(reading); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *ndjsonExporter) close() error {
+	return nil
+}
+
+// parquetSchema is the schema of Parquet exports.
+var parquetSchema = parquetschema.SchemaDefinitionFromColumnDefinition(&parquetschema.ColumnDefinition{
+	SchemaElement: &parquet.SchemaElement{Name: "sensor_reading"},
+	Children: []*parquetschema.ColumnDefinition{
+		{SchemaElement: parquetColumn("time", parquet.Type_INT64, &parquet.LogicalType{
+			TIMESTAMP: &parquet.TimestampType{IsAdjustedToUTC: true, Unit: &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()}},
+		})},
+		{SchemaElement: parquetColumn("sensor_name", parquet.Type_BYTE_ARRAY, &parquet.LogicalType{
+			STRING: parquet.NewStringType(),
+		})},
+		{SchemaElement: parquetColumn("value", parquet.Type_DOUBLE, nil)},
+		{SchemaElement: parquetStringColumn("unit", parquet.FieldRepetitionType_OPTIONAL)},
+		{SchemaElement: parquetStringColumn("quality", parquet.FieldRepetitionType_REQUIRED)},
+		{SchemaElement: parquetStringColumn("annotation", parquet.FieldRepetitionType_OPTIONAL)},
+	},
+})
+
+func parquetColumn(name string, typ parquet.Type, logicalType *parquet.LogicalType) *parquet.SchemaElement {
+	return &parquet.SchemaElement{
+		Name:           name,
+		Type:           parquet.TypePtr(typ),
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
+		LogicalType:    logicalType,
+	}
+}
+
+func parquetStringColumn(name string, repetition parquet.FieldRepetitionType) *parquet.SchemaElement {
+	column := parquetColumn(name, parquet.Type_BYTE_ARRAY, &parquet.LogicalType{STRING: parquet.NewStringType()})
+	column.RepetitionType = parquet.FieldRepetitionTypePtr(repetition)
+
+	return column
+}
+
+type parquetExporter struct {
+	writer *goparquet.FileWriter
+}
+
+func (e *parquetExporter) write(readings []*models.SensorReading) error {
+	for _, reading := range readings {
+		row := map[string]interface{}{
+			"time":        reading.Time.UnixMicro(),
+			"sensor_name": []byte(reading.SensorName),
+			"value":       reading.Value,
+			"quality":     []byte(reading.Quality),
+		}
+
+		// the optional columns are null when left out of the row
+		if reading.Unit != "" {
+			row["unit"] = []byte(reading.Unit)
+		}
+
+		if reading.Annotation != "" {
+			row["annotation"] = []byte(reading.Annotation)
+		}
+
+		if err := e.writer.AddData(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *parquetExporter) close() error {
+	return e.writer.Close()
+}
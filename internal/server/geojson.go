@@ -0,0 +1,275 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+
+	"github.com/koneal2013/sensorsphere/internal/middleware/adaptor"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// geoJSONMediaType is the media type GIS clients ask for and send GeoJSON with.
+const geoJSONMediaType = "application/geo+json"
+
+// featureCollection is a GeoJSON FeatureCollection. NextPageToken is a foreign member carrying the token of the
+// following page, which GeoJSON readers ignore.
+type featureCollection struct {
+	Type          string             `json:"type"`
+	Features      []*geojson.Feature `json:"features"`
+	NextPageToken string             `json:"nextPageToken,omitempty"`
+}
+
+// sensorCollection is the sensors a sensor endpoint responds with, ready to be written as GeoJSON.
+type sensorCollection struct {
+	sensors []*models.Sensor
+	// distances holds the distance in metres of each sensor from the point searched around, if any.
+	distances     []float64
+	nextPageToken string
+}
+
+func sensorPageCollection(page *models.SensorPage) sensorCollection {
+	return sensorCollection{sensors: page.Sensors, nextPageToken: page.NextPageToken}
+}
+
+func sensorDistancePageCollection(page *models.SensorDistancePage) sensorCollection {
+	collection := sensorDistancesCollection(page.Sensors)
+	collection.nextPageToken = page.NextPageToken
+
+	return collection
+}
+
+func nearestSensorCollection(sensor *models.Sensor) sensorCollection {
+	return sensorCollection{sensors: []*models.Sensor{sensor}}
+}
+
+func nearestSensorsCollection(res *models.NearestSensorsResponse) sensorCollection {
+	return sensorDistancesCollection(res.Sensors)
+}
+
+func sensorDistancesCollection(sensors []*models.SensorDistance) sensorCollection {
+	collection := sensorCollection{
+		sensors:   make([]*models.Sensor, len(sensors)),
+		distances: make([]float64, len(sensors)),
+	}
+
+	for i, sensor := range sensors {
+		collection.sensors[i] = &sensor.Sensor
+		collection.distances[i] = sensor.Distance
+	}
+
+	return collection
+}
+
+// sensorsEncoder returns an encoder for adaptor.GenericHttpAdaptorWithEncoder that writes the sensors collect
+// picks out of a handler's output as a GeoJSON FeatureCollection when the client accepts application/geo+json,
+// and writes the output as JSON otherwise.
+func sensorsEncoder[T any](s *SensorSphere,
+	collect func(T) sensorCollection,
+) func(http.ResponseWriter, *http.Request, T) error {
+	return func(w http.ResponseWriter, r *http.Request, out T) error {
+		if !acceptsGeoJSON(r.Header.Get("Accept")) {
+			return adaptor.GenericEncoder(w, out)
+		}
+
+		collection, err := s.sensorFeatureCollection(r, collect(out))
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", geoJSONMediaType)
+
+		return json.NewEncoder(w).Encode(collection)
+	}
+}
+
+// acceptsGeoJSON reports whether an Accept header lists GeoJSON before any other JSON media type.
+func acceptsGeoJSON(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case geoJSONMediaType:
+			return true
+		case "application/json", "application/*", "*/*":
+			return false
+		}
+	}
+
+	return false
+}
+
+// sensorFeatureCollection turns sensors into a FeatureCollection with a Point feature per sensor, whose
+// properties carry the sensor's fields and the value and time of its latest reading.
+func (s *SensorSphere) sensorFeatureCollection(r *http.Request,
+	sensors sensorCollection,
+) (*featureCollection, error) {
+	ctx, span := s.HttpTracer.Start(r.Context(), "sensorFeatureCollection")
+	defer span.End()
+
+	latest := map[string]*models.SensorReading{}
+
+	if len(sensors.sensors) > 0 {
+		names := make([]string, len(sensors.sensors))
+		for i, sensor := range sensors.sensors {
+			names[i] = sensor.Name
+		}
+
+		readings, err := s.database.GetLatestReadings(ctx, models.LatestReadingsQuery{SensorNames: names})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reading := range readings {
+			latest[reading.SensorName] = reading
+		}
+	}
+
+	collection := &featureCollection{
+		Type:          "FeatureCollection",
+		Features:      make([]*geojson.Feature, len(sensors.sensors)),
+		NextPageToken: sensors.nextPageToken,
+	}
+
+	for i, sensor := range sensors.sensors {
+		feature := sensorFeature(sensor, latest[sensor.Name])
+		if sensors.distances != nil {
+			feature.Properties["distance"] = sensors.distances[i]
+		}
+
+		collection.Features[i] = feature
+	}
+
+	return collection, nil
+}
+
+// sensorFeature returns sensor as a GeoJSON Feature identified by the sensor's name. Sensors without a location
+// have a null geometry.
+func sensorFeature(sensor *models.Sensor, latest *models.SensorReading) *geojson.Feature {
+	tags := sensor.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	properties := map[string]interface{}{
+		"name": sensor.Name,
+		"tags": tags,
+	}
+
+	if sensor.MeasurementType != "" {
+		properties["measurementType"] = sensor.MeasurementType
+	}
+
+	if sensor.Unit != "" {
+		properties["unit"] = sensor.Unit
+	}
+
+	if sensor.MinValue != nil {
+		properties["minValue"] = *sensor.MinValue
+	}
+
+	if sensor.MaxValue != nil {
+		properties["maxValue"] = *sensor.MaxValue
+	}
+
+	if sensor.RangePolicy != "" {
+		properties["rangePolicy"] = sensor.RangePolicy
+	}
+
+	if latest != nil {
+		properties["latestValue"] = latest.Value
+		properties["latestTime"] = latest.Time
+		properties["latestQuality"] = latest.Quality
+	}
+
+	var geometry geom.T
+
+	// a sensor is never created at 0,0, which is how sensors without a location come back
+	if sensor.Location != (models.Location{}) {
+		geometry = geom.NewPointFlat(geom.XY, []float64{sensor.Location.Longitude, sensor.Location.Latitude})
+	}
+
+	return &geojson.Feature{ID: sensor.Name, Geometry: geometry, Properties: properties}
+}
+
+// geoJSONSensorBody lets next, which takes a sensor as JSON, also take it as a GeoJSON Feature with a Point
+// geometry sent as application/geo+json. The feature's properties hold the sensor's fields; its name defaults to
+// the feature's id and then to the name in the path.
+func geoJSONSensorBody(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != geoJSONMediaType {
+			next(w, r)
+			return
+		}
+
+		sensor, err := decodeSensorFeature(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if sensor.Name == "" {
+			sensor.Name = mux.Vars(r)["name"]
+		}
+
+		body, err := json.Marshal(sensor)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+
+		next(w, r)
+	}
+}
+
+// decodeSensorFeature reads a sensor from a GeoJSON Feature.
+func decodeSensorFeature(body io.ReadCloser) (*models.Sensor, error) {
+	defer body.Close()
+
+	var feature geojson.Feature
+	if err := json.NewDecoder(body).Decode(&feature); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON feature: %w", err)
+	}
+
+	point, ok := feature.Geometry.(*geom.Point)
+	if !ok {
+		return nil, errors.New("sensor features must have a Point geometry")
+	}
+
+	var sensor models.Sensor
+
+	if feature.Properties != nil {
+		properties, err := json.Marshal(feature.Properties)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(properties, &sensor); err != nil {
+			return nil, fmt.Errorf("invalid sensor properties: %w", err)
+		}
+	}
+
+	if sensor.Name == "" {
+		sensor.Name = feature.ID
+	}
+
+	sensor.Location = models.Location{Longitude: point.X(), Latitude: point.Y()}
+
+	return &sensor, nil
+}
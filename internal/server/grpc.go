@@ -2,6 +2,9 @@ package server
 
 import (
 	"context"
+	"errors"
+	"io"
+	"strconv"
 	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -16,18 +19,29 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	peer2 "google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	grpc_api "github.com/koneal2013/sensorsphere/api/v1/grpc"
 	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/interpolation"
 	"github.com/koneal2013/sensorsphere/internal/models"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
 )
 
 const (
 	objectWildCard = "*"
+
+	defaultStreamBatchSize     = 500
+	defaultStreamFlushInterval = time.Second
+	maxIngestRejections        = 100
+	watchBufferSize            = 256
+	droppedReadingsTrailer     = "dropped-readings"
 )
 
 type Authorizer interface {
@@ -35,7 +49,16 @@ type Authorizer interface {
 }
 
 type GrpcConfig struct {
-	Db db.Database
+	Db                db.Database
+	ReadingTimeWindow ReadingTimeWindow
+	// StreamBatchSize and StreamFlushInterval bound how many readings StreamSensorReadings buffers, and for
+	// how long, before writing them to the database.
+	StreamBatchSize     int
+	StreamFlushInterval time.Duration
+	// Hub publishes stored readings to WatchSensorReadings subscribers.
+	Hub *pubsub.Hub
+	// MaxQuerySeries caps how many sensors one GetSensorReadingSeries query may select (default 100).
+	MaxQuerySeries int
 	Authorizer
 }
 
@@ -93,8 +116,17 @@ func (s *grpcServer) CreateSensor(ctx context.Context, in *grpc_api.Sensor) (*gr
 	}
 
 	newSensor := apiSensorToModel(in)
+	if err := validateSensorRange(newSensor); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	sensor, err := s.database.CreateSensor(ctx, newSensor)
-	if err != nil {
+	switch {
+	case errors.Is(err, db.ErrSensorExists):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, db.ErrSensorDeleted):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
 		return nil, err
 	}
 
@@ -110,12 +142,32 @@ func (s *grpcServer) GetSensor(ctx context.Context, in *grpc_api.GetSensorReques
 	}
 
 	sensor, err := s.database.GetSensor(ctx, in.Name)
-	if err != nil {
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
 		return nil, err
 	}
 	return modelSensorToAPI(sensor), nil
 }
 
+func (s *grpcServer) ListSensors(ctx context.Context,
+	in *grpc_api.ListSensorsRequest) (*grpc_api.ListSensorsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "ListSensors")
+	defer span.End()
+
+	page, err := s.database.ListSensors(ctx, apiListSensorsRequestToModel(in))
+	if errors.Is(err, db.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.ListSensorsResponse{
+		Sensors:       modelSensorsToAPI(page.Sensors),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
 func (s *grpcServer) UpdateSensor(ctx context.Context, in *grpc_api.Sensor) (*grpc_api.UpdateSensorResponse, error) {
 	ctx, span := s.grpcTracer.Start(ctx, "UpdateSensor")
 	defer span.End()
@@ -125,6 +177,10 @@ func (s *grpcServer) UpdateSensor(ctx context.Context, in *grpc_api.Sensor) (*gr
 	}
 
 	updatedSensor := apiSensorToModel(in)
+	if err := validateSensorRange(updatedSensor); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	rows, err := s.database.UpdateSensor(ctx, updatedSensor)
 	if err != nil {
 		return nil, err
@@ -133,6 +189,33 @@ func (s *grpcServer) UpdateSensor(ctx context.Context, in *grpc_api.Sensor) (*gr
 	return &grpc_api.UpdateSensorResponse{RowsAffected: rows}, nil
 }
 
+func (s *grpcServer) DeleteSensor(ctx context.Context,
+	in *grpc_api.DeleteSensorRequest) (*grpc_api.DeleteSensorResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "DeleteSensor")
+	defer span.End()
+
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	mode, ok := apiDeleteModeToModel[in.Mode]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, db.ErrInvalidDeleteMode.Error())
+	}
+
+	readingsDeleted, err := s.database.DeleteSensor(ctx, in.Name, mode)
+	switch {
+	case errors.Is(err, db.ErrSensorNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, db.ErrSensorHasReadings):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &grpc_api.DeleteSensorResponse{ReadingsDeleted: readingsDeleted}, nil
+}
+
 func (s *grpcServer) GetNearestSensor(ctx context.Context, in *grpc_api.Location) (*grpc_api.Sensor, error) {
 	ctx, span := s.grpcTracer.Start(ctx, "GetNearestSensor")
 	defer span.End()
@@ -143,13 +226,112 @@ func (s *grpcServer) GetNearestSensor(ctx context.Context, in *grpc_api.Location
 
 	location := apiLocationToModel(in)
 	sensor, err := s.database.GetNearestSensor(ctx, location)
-	if err != nil {
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
 		return nil, err
 	}
 
 	return modelSensorToAPI(sensor), nil
 }
 
+func (s *grpcServer) FindNearestSensors(ctx context.Context,
+	in *grpc_api.FindNearestSensorsRequest) (*grpc_api.FindNearestSensorsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "FindNearestSensors")
+	defer span.End()
+
+	query := models.NearestQuery{
+		K:              int(in.K),
+		MaxDistance:    in.GetMaxDistanceMeters(),
+		AnyTags:        in.AnyTags,
+		AllTags:        in.AllTags,
+		ReportedWithin: models.Duration(in.ReportedWithin.AsDuration()),
+	}
+	if in.Location != nil {
+		query.Longitude, query.Latitude = in.Location.Longitude, in.Location.Latitude
+	}
+
+	if err := validateNearestQuery(&query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sensors, err := s.database.FindNearestSensors(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.FindNearestSensorsResponse{Sensors: modelSensorDistancesToAPI(sensors)}, nil
+}
+
+func (s *grpcServer) FindSensorsWithinRadius(ctx context.Context,
+	in *grpc_api.FindSensorsWithinRadiusRequest) (*grpc_api.FindSensorsWithinRadiusResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "FindSensorsWithinRadius")
+	defer span.End()
+
+	query := models.RadiusQuery{
+		Radius:    in.RadiusMeters,
+		AnyTags:   in.AnyTags,
+		AllTags:   in.AllTags,
+		PageSize:  int(in.PageSize),
+		PageToken: in.PageToken,
+	}
+	if in.Location != nil {
+		query.Longitude, query.Latitude = in.Location.Longitude, in.Location.Latitude
+	}
+
+	if err := validateRadiusQuery(query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := s.database.FindSensorsWithinRadius(ctx, query)
+	if errors.Is(err, db.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.FindSensorsWithinRadiusResponse{
+		Sensors:       modelSensorDistancesToAPI(page.Sensors),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *grpcServer) FindSensorsInArea(ctx context.Context,
+	in *grpc_api.FindSensorsInAreaRequest) (*grpc_api.ListSensorsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "FindSensorsInArea")
+	defer span.End()
+
+	query := models.AreaQuery{
+		AnyTags:   in.AnyTags,
+		AllTags:   in.AllTags,
+		PageSize:  int(in.PageSize),
+		PageToken: in.PageToken,
+	}
+
+	switch area := in.Area.(type) {
+	case *grpc_api.FindSensorsInAreaRequest_BoundingBox:
+		query.BoundingBox = apiBoundingBoxToModel(area.BoundingBox)
+	case *grpc_api.FindSensorsInAreaRequest_Geojson:
+		query.Geometry = []byte(area.Geojson)
+	}
+
+	if err := validateAreaQuery(&query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := s.database.FindSensorsInArea(ctx, query)
+	if errors.Is(err, db.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.ListSensorsResponse{
+		Sensors:       modelSensorsToAPI(page.Sensors),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
 func (s *grpcServer) CreateSensorReading(ctx context.Context,
 	in *grpc_api.SensorReading) (*grpc_api.SensorReading, error) {
 	ctx, span := s.grpcTracer.Start(ctx, "CreateSensorReading")
@@ -160,14 +342,180 @@ func (s *grpcServer) CreateSensorReading(ctx context.Context,
 	}
 
 	reading := apiReadingToModel(in)
+	if err := s.ReadingTimeWindow.Check(reading.Time, time.Now()); err != nil {
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
+
 	sensorReading, err := s.database.CreateSensorReading(ctx, reading)
-	if err != nil {
+	switch {
+	case errors.Is(err, db.ErrSensorNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, db.ErrDuplicateReading):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, db.ErrValueOutOfRange):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
 		return nil, err
 	}
 
 	return modelReadingToAPI(sensorReading), nil
 }
 
+func (s *grpcServer) FlagSensorReadings(ctx context.Context,
+	in *grpc_api.FlagSensorReadingsRequest) (*grpc_api.FlagSensorReadingsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "FlagSensorReadings")
+	defer span.End()
+
+	req := models.FlagReadingsRequest{
+		SensorName: in.SensorName,
+		Quality:    apiQualityToModel[in.Quality],
+		Annotation: in.Annotation,
+	}
+	if in.StartTime != nil {
+		req.StartTime = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		req.EndTime = in.EndTime.AsTime()
+	}
+
+	if err := validateFlagReadingsRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.database.FlagSensorReadings(ctx, req)
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.FlagSensorReadingsResponse{Updated: updated}, nil
+}
+
+func (s *grpcServer) CreateSensorReadings(ctx context.Context,
+	in *grpc_api.CreateSensorReadingsRequest) (*grpc_api.CreateSensorReadingsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "CreateSensorReadings")
+	defer span.End()
+
+	req := models.BatchSensorReadingsRequest{
+		Readings: make([]*models.SensorReading, len(in.Readings)),
+		Atomic:   in.Atomic,
+	}
+	for i, reading := range in.Readings {
+		req.Readings[i] = apiReadingToModel(reading)
+	}
+
+	res, err := createSensorReadings(ctx, s.database, s.ReadingTimeWindow, req)
+	if errors.Is(err, errMissingFields) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return modelBatchResponseToAPI(res), nil
+}
+
+// StreamSensorReadings ingests readings from a long-lived client stream, writing them in micro-batches. Messages
+// keep being received while a batch is written, but only into a buffer of one batch; once that is full receiving
+// stops, so gRPC flow control slows down clients that outpace the database and the server holds at most about
+// two batches per stream.
+func (s *grpcServer) StreamSensorReadings(stream grpc_api.SensorSphereService_StreamSensorReadingsServer) error {
+	ctx, span := s.grpcTracer.Start(stream.Context(), "StreamSensorReadings")
+	defer span.End()
+
+	batchSize := s.StreamBatchSize
+	if batchSize <= 0 || batchSize > maxBatchSize {
+		batchSize = defaultStreamBatchSize
+	}
+
+	flushInterval := s.StreamFlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultStreamFlushInterval
+	}
+
+	// the receiving goroutine blocks once a batch's worth of readings is waiting, bounding what is buffered
+	received := make(chan *grpc_api.SensorReading, batchSize)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		defer close(received)
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case received <- in:
+			case <-ctx.Done():
+				recvErr <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	summary := &grpc_api.IngestSummary{}
+	batch := make([]*models.SensorReading, 0, batchSize)
+	var offset int
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		res, err := createSensorReadings(ctx, s.database, s.ReadingTimeWindow,
+			models.BatchSensorReadingsRequest{Readings: batch})
+		if err != nil {
+			return err
+		}
+
+		summary.Accepted += int64(res.Accepted)
+		summary.Rejected += int64(res.Rejected)
+		for _, result := range res.Results {
+			if result.Error != "" && len(summary.Rejections) < maxIngestRejections {
+				summary.Rejections = append(summary.Rejections, &grpc_api.ReadingResult{
+					Index: int32(offset + result.Index),
+					Error: result.Error,
+				})
+			}
+		}
+
+		offset += len(batch)
+		batch = make([]*models.SensorReading, 0, batchSize)
+
+		return nil
+	}
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case in, ok := <-received:
+			if !ok {
+				if err := <-recvErr; err != io.EOF {
+					return err
+				}
+				if err := flush(); err != nil {
+					return err
+				}
+				return stream.SendAndClose(summary)
+			}
+
+			batch = append(batch, apiReadingToModel(in))
+			if len(batch) >= batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (s *grpcServer) GetSensorReadingsForTimeRange(ctx context.Context,
 	in *grpc_api.TimeRangeQuery) (*grpc_api.SensorReadingsResponse, error) {
 	ctx, span := s.grpcTracer.Start(ctx, "GetSensorReadingsForTimeRange")
@@ -178,12 +526,425 @@ func (s *grpcServer) GetSensorReadingsForTimeRange(ctx context.Context,
 	}
 
 	timeRange := apiTimeRangeQueryToModel(in)
-	sensorReadings, err := s.database.GetSensorReadingsForTimeRange(ctx, timeRange)
+	page, err := s.database.GetSensorReadingsForTimeRange(ctx, timeRange)
+	if errors.Is(err, db.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.SensorReadingsResponse{
+		SensorReadings: modelReadingsToAPI(page.SensorReadings),
+		NextPageToken:  page.NextPageToken,
+	}, nil
+}
+
+// ExportSensorReadings streams every reading of a sensor in the time range, one message per chunk of readings
+// fetched from the database, so that ranges of any size stay under the message size limit.
+func (s *grpcServer) ExportSensorReadings(in *grpc_api.ExportSensorReadingsRequest,
+	stream grpc_api.SensorSphereService_ExportSensorReadingsServer,
+) error {
+	ctx, span := s.grpcTracer.Start(stream.Context(), "ExportSensorReadings")
+	defer span.End()
+
+	if in.SensorName == "" || in.StartTime == nil || in.EndTime == nil {
+		return status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	query := models.ExportQuery{
+		SensorNames: []string{in.SensorName},
+		StartTime:   in.StartTime.AsTime(),
+		EndTime:     in.EndTime.AsTime(),
+		Order:       models.SortOrderAsc,
+		GoodOnly:    in.GoodOnly,
+	}
+	if in.Order == grpc_api.SortOrder_SORT_ORDER_DESC {
+		query.Order = models.SortOrderDesc
+	}
+
+	return s.database.ExportSensorReadings(ctx, query, int(in.ChunkSize), func(readings []*models.SensorReading) error {
+		return stream.Send(&grpc_api.SensorReadingsResponse{SensorReadings: modelReadingsToAPI(readings)})
+	})
+}
+
+func (s *grpcServer) GetSensorReadingSeries(ctx context.Context,
+	in *grpc_api.SeriesQuery) (*grpc_api.SeriesResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "GetSensorReadingSeries")
+	defer span.End()
+
+	query := models.SeriesQuery{
+		SensorNames: in.SensorNames,
+		AnyTags:     in.AnyTags,
+		AllTags:     in.AllTags,
+		Layout:      apiSeriesLayoutToModel[in.Layout],
+		GoodOnly:    in.GoodOnly,
+	}
+	if in.StartTime != nil {
+		query.StartTime = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		query.EndTime = in.EndTime.AsTime()
+	}
+
+	if err := validateSeriesQuery(&query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	series, err := s.database.GetSensorReadingSeries(ctx, query, maxQuerySeriesOrDefault(s.MaxQuerySeries),
+		maxSeriesReadings)
+	if errors.Is(err, db.ErrTooManySeries) || errors.Is(err, db.ErrTooManyReadings) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return modelSeriesResponseToAPI(layoutSeries(query.Layout, series)), nil
+}
+
+func (s *grpcServer) GetLatestReadings(ctx context.Context,
+	in *grpc_api.GetLatestReadingsRequest) (*grpc_api.SensorReadingsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "GetLatestReadings")
+	defer span.End()
+
+	query := models.LatestReadingsQuery{SensorNames: in.SensorNames, Tags: in.Tags, GoodOnly: in.GoodOnly}
+	if err := validateLatestReadingsQuery(query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	readings, err := s.database.GetLatestReadings(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.SensorReadingsResponse{SensorReadings: modelReadingsToAPI(readings)}, nil
+}
+
+func (s *grpcServer) AggregateSensorReadings(ctx context.Context,
+	in *grpc_api.AggregationQuery) (*grpc_api.AggregationResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "AggregateSensorReadings")
+	defer span.End()
+
+	query := apiAggregationQueryToModel(in)
+	if err := validateAggregationQuery(&query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	buckets, err := s.database.AggregateSensorReadings(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &grpc_api.SensorReadingsResponse{SensorReadings: modelReadingsToAPI(sensorReadings)}, nil
+	return &grpc_api.AggregationResponse{Buckets: modelBucketsToAPI(buckets)}, nil
+}
+
+func (s *grpcServer) ResampleSensorReadings(ctx context.Context,
+	in *grpc_api.ResampleQuery) (*grpc_api.ResampleResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "ResampleSensorReadings")
+	defer span.End()
+
+	query := models.ResampleQuery{
+		SensorName: in.SensorName,
+		Interval:   models.Duration(in.Interval.AsDuration()),
+		Fill:       apiFillStrategyToModel[in.Fill],
+		FillValue:  in.FillValue,
+		GoodOnly:   in.GoodOnly,
+	}
+	if in.StartTime != nil {
+		query.StartTime = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		query.EndTime = in.EndTime.AsTime()
+	}
+
+	if err := validateResampleQuery(&query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	readings, err := s.database.ResampleSensorReadings(ctx, query)
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := &grpc_api.ResampleResponse{Readings: make([]*grpc_api.ResampledReading, len(readings))}
+	for i, reading := range readings {
+		res.Readings[i] = &grpc_api.ResampledReading{
+			SensorName:  reading.SensorName,
+			Time:        timestamppb.New(reading.Time),
+			Value:       reading.Value,
+			Synthesized: reading.Synthesized,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) InterpolateSensorReadings(ctx context.Context,
+	in *grpc_api.InterpolateSensorReadingsRequest) (*grpc_api.InterpolateSensorReadingsResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "InterpolateSensorReadings")
+	defer span.End()
+
+	query := models.InterpolationQuery{
+		Method:          apiInterpolationMethodToModel[in.Method],
+		K:               int(in.K),
+		Window:          models.Duration(in.Window.AsDuration()),
+		MaxDistance:     in.GetMaxDistanceMeters(),
+		MeasurementType: in.MeasurementType,
+		AnyTags:         in.AnyTags,
+		AllTags:         in.AllTags,
+		GoodOnly:        in.GoodOnly,
+		Power:           in.GetPower(),
+		Range:           in.GetRangeMeters(),
+	}
+	if in.Location != nil {
+		query.Longitude, query.Latitude = in.Location.Longitude, in.Location.Latitude
+	}
+	if in.Time != nil {
+		query.Time = in.Time.AsTime()
+	}
+
+	if err := validateInterpolationQuery(&query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sources, err := s.database.GetInterpolationSources(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := interpolate(query, sources)
+	switch {
+	case errors.Is(err, errNoInterpolationSources):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errMixedUnits), errors.Is(err, interpolation.ErrCoincidentSamples):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	res := &grpc_api.InterpolateSensorReadingsResponse{
+		Value:    result.Value,
+		Unit:     result.Unit,
+		Method:   modelInterpolationMethodToAPI[result.Method],
+		Variance: result.Variance,
+		Sensors:  make([]*grpc_api.InterpolationSource, len(result.Sensors)),
+	}
+	for i, source := range result.Sensors {
+		res.Sensors[i] = &grpc_api.InterpolationSource{
+			SensorName:     source.SensorName,
+			Location:       &grpc_api.Location{Longitude: source.Location.Longitude, Latitude: source.Location.Latitude},
+			DistanceMeters: source.Distance,
+			Time:           timestamppb.New(source.Time),
+			Value:          source.Value,
+			Unit:           source.Unit,
+			Weight:         source.Weight,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) ListRetentionPolicies(ctx context.Context,
+	_ *grpc_api.ListRetentionPoliciesRequest) (*grpc_api.ListRetentionPoliciesResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "ListRetentionPolicies")
+	defer span.End()
+
+	policies, err := s.database.ListRetentionPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &grpc_api.ListRetentionPoliciesResponse{Policies: make([]*grpc_api.RetentionPolicy, len(policies))}
+	for i, policy := range policies {
+		res.Policies[i] = modelRetentionPolicyToAPI(policy)
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) SetRetentionPolicy(ctx context.Context,
+	in *grpc_api.RetentionPolicy) (*grpc_api.RetentionPolicy, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "SetRetentionPolicy")
+	defer span.End()
+
+	policy := &models.RetentionPolicy{
+		Scope:  apiRetentionScopeToModel[in.Scope],
+		Target: in.Target,
+		MaxAge: models.Duration(in.MaxAge.AsDuration()),
+	}
+	if err := validateRetentionPolicy(policy); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	policy, err := s.database.SetRetentionPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return modelRetentionPolicyToAPI(policy), nil
+}
+
+func (s *grpcServer) DeleteRetentionPolicy(ctx context.Context,
+	in *grpc_api.DeleteRetentionPolicyRequest) (*grpc_api.DeleteRetentionPolicyResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "DeleteRetentionPolicy")
+	defer span.End()
+
+	err := s.database.DeleteRetentionPolicy(ctx, apiRetentionScopeToModel[in.Scope], in.Target)
+	if errors.Is(err, db.ErrRetentionPolicyNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &grpc_api.DeleteRetentionPolicyResponse{}, nil
+}
+
+func (s *grpcServer) ApplyRetention(ctx context.Context,
+	in *grpc_api.ApplyRetentionRequest) (*grpc_api.RetentionReport, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "ApplyRetention")
+	defer span.End()
+
+	report, err := s.database.ApplyRetention(ctx, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &grpc_api.RetentionReport{
+		DryRun:          report.DryRun,
+		DroppedChunks:   report.DroppedChunks,
+		Sensors:         make([]*grpc_api.SensorRetention, len(report.Sensors)),
+		ReadingsDeleted: report.ReadingsDeleted,
+	}
+	for i, sensor := range report.Sensors {
+		res.Sensors[i] = &grpc_api.SensorRetention{
+			SensorName:      sensor.SensorName,
+			MaxAge:          durationpb.New(time.Duration(sensor.MaxAge)),
+			Cutoff:          timestamppb.New(sensor.Cutoff),
+			ReadingsDeleted: sensor.ReadingsDeleted,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) GetCompressionSettings(ctx context.Context,
+	_ *grpc_api.GetCompressionSettingsRequest) (*grpc_api.CompressionSettings, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "GetCompressionSettings")
+	defer span.End()
+
+	settings, err := s.database.GetCompressionSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return modelCompressionSettingsToAPI(settings), nil
+}
+
+func (s *grpcServer) SetCompression(ctx context.Context,
+	in *grpc_api.SetCompressionRequest) (*grpc_api.CompressionSettings, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "SetCompression")
+	defer span.End()
+
+	req := models.SetCompressionRequest{
+		Enabled:       in.Enabled,
+		CompressAfter: models.Duration(in.CompressAfter.AsDuration()),
+	}
+	if err := validateSetCompressionRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	settings, err := s.database.SetCompression(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return modelCompressionSettingsToAPI(settings), nil
+}
+
+func (s *grpcServer) ListChunkCompression(ctx context.Context,
+	_ *grpc_api.ListChunkCompressionRequest) (*grpc_api.ListChunkCompressionResponse, error) {
+	ctx, span := s.grpcTracer.Start(ctx, "ListChunkCompression")
+	defer span.End()
+
+	chunks, err := s.database.ListChunkCompression(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &grpc_api.ListChunkCompressionResponse{Chunks: make([]*grpc_api.ChunkCompression, len(chunks))}
+	for i, chunk := range chunks {
+		res.Chunks[i] = &grpc_api.ChunkCompression{
+			Chunk:                  chunk.Chunk,
+			RangeStart:             timestamppb.New(chunk.RangeStart),
+			RangeEnd:               timestamppb.New(chunk.RangeEnd),
+			Compressed:             chunk.Compressed,
+			BytesBeforeCompression: chunk.BytesBeforeCompression,
+			BytesAfterCompression:  chunk.BytesAfterCompression,
+			Ratio:                  chunk.Ratio,
+		}
+	}
+
+	return res, nil
+}
+
+// WatchSensorReadings streams newly stored readings matching the request, optionally preceded by the stored
+// readings taken since the requested time. The number of readings dropped because the client fell behind is
+// reported in the dropped-readings trailer.
+func (s *grpcServer) WatchSensorReadings(in *grpc_api.WatchSensorReadingsRequest,
+	stream grpc_api.SensorSphereService_WatchSensorReadingsServer,
+) error {
+	ctx, span := s.grpcTracer.Start(stream.Context(), "WatchSensorReadings")
+	defer span.End()
+
+	if s.Hub == nil {
+		return status.Error(codes.Unimplemented, "live readings are not enabled")
+	}
+
+	filter := pubsub.Filter{SensorNames: in.SensorNames, Tags: in.Tags}
+	if in.BoundingBox != nil {
+		filter.BoundingBox = apiBoundingBoxToModel(in.BoundingBox)
+	}
+
+	policy := pubsub.DropReadings
+	if in.SlowConsumerPolicy == grpc_api.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DISCONNECT {
+		policy = pubsub.Disconnect
+	}
+
+	// subscribe before replaying so nothing stored in the meantime is missed
+	sub := s.Hub.Subscribe(filter, policy, watchBufferSize)
+	defer sub.Close()
+	defer func() {
+		stream.SetTrailer(metadata.Pairs(droppedReadingsTrailer, strconv.FormatUint(sub.Dropped(), 10)))
+	}()
+
+	send := func(reading *models.SensorReading) error {
+		return stream.Send(modelReadingToAPI(reading))
+	}
+
+	if in.Since != nil {
+		err := pubsub.Replay(ctx, s.database, sub, pubsub.Position{Time: in.Since.AsTime()}, time.Now(), send)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case reading := <-sub.Readings():
+			if err := send(reading); err != nil {
+				return err
+			}
+		case <-sub.Done():
+			if errors.Is(sub.Err(), pubsub.ErrHubClosed) {
+				return status.Error(codes.Unavailable, sub.Err().Error())
+			}
+
+			return status.Error(codes.ResourceExhausted, sub.Err().Error())
+		case <-ctx.Done():
+			return nil
+		}
+	}
 }
 
 func apiSensorToModel(in *grpc_api.Sensor) *models.Sensor {
@@ -193,7 +954,12 @@ func apiSensorToModel(in *grpc_api.Sensor) *models.Sensor {
 			Longitude: in.Location.Longitude,
 			Latitude:  in.Location.Latitude,
 		},
-		Tags: in.Tags,
+		Tags:            in.Tags,
+		MeasurementType: in.MeasurementType,
+		Unit:            in.Unit,
+		MinValue:        in.MinValue,
+		MaxValue:        in.MaxValue,
+		RangePolicy:     apiRangePolicyToModel[in.RangePolicy],
 	}
 }
 
@@ -204,7 +970,168 @@ func modelSensorToAPI(sensor *models.Sensor) *grpc_api.Sensor {
 			Longitude: sensor.Location.Longitude,
 			Latitude:  sensor.Location.Latitude,
 		},
-		Tags: sensor.Tags,
+		Tags:            sensor.Tags,
+		MeasurementType: sensor.MeasurementType,
+		Unit:            sensor.Unit,
+		MinValue:        sensor.MinValue,
+		MaxValue:        sensor.MaxValue,
+		RangePolicy:     modelRangePolicyToAPI[sensor.RangePolicy],
+	}
+}
+
+func modelSensorDistancesToAPI(sensors []*models.SensorDistance) []*grpc_api.SensorDistance {
+	apiSensors := make([]*grpc_api.SensorDistance, len(sensors))
+	for i, sensor := range sensors {
+		apiSensors[i] = &grpc_api.SensorDistance{Sensor: modelSensorToAPI(&sensor.Sensor), DistanceMeters: sensor.Distance}
+	}
+	return apiSensors
+}
+
+var apiRangePolicyToModel = map[grpc_api.RangePolicy]models.RangePolicy{
+	grpc_api.RangePolicy_RANGE_POLICY_UNSPECIFIED: models.RangePolicyReject,
+	grpc_api.RangePolicy_RANGE_POLICY_REJECT:      models.RangePolicyReject,
+	grpc_api.RangePolicy_RANGE_POLICY_FLAG:        models.RangePolicyFlag,
+}
+
+var modelRangePolicyToAPI = map[models.RangePolicy]grpc_api.RangePolicy{
+	models.RangePolicyReject: grpc_api.RangePolicy_RANGE_POLICY_REJECT,
+	models.RangePolicyFlag:   grpc_api.RangePolicy_RANGE_POLICY_FLAG,
+}
+
+var apiDeleteModeToModel = map[grpc_api.DeleteMode]models.DeleteMode{
+	grpc_api.DeleteMode_DELETE_MODE_UNSPECIFIED: models.DeleteModeRestrict,
+	grpc_api.DeleteMode_DELETE_MODE_RESTRICT:    models.DeleteModeRestrict,
+	grpc_api.DeleteMode_DELETE_MODE_CASCADE:     models.DeleteModeCascade,
+	grpc_api.DeleteMode_DELETE_MODE_SOFT:        models.DeleteModeSoft,
+}
+
+var apiSeriesLayoutToModel = map[grpc_api.SeriesLayout]models.SeriesLayout{
+	grpc_api.SeriesLayout_SERIES_LAYOUT_UNSPECIFIED: models.SeriesLayoutGrouped,
+	grpc_api.SeriesLayout_SERIES_LAYOUT_GROUPED:     models.SeriesLayoutGrouped,
+	grpc_api.SeriesLayout_SERIES_LAYOUT_WIDE:        models.SeriesLayoutWide,
+}
+
+func modelSeriesResponseToAPI(res *models.SeriesResponse) *grpc_api.SeriesResponse {
+	apiRes := &grpc_api.SeriesResponse{
+		Layout:  grpc_api.SeriesLayout_SERIES_LAYOUT_GROUPED,
+		Series:  make([]*grpc_api.Series, len(res.Series)),
+		Columns: res.Columns,
+		Units:   res.Units,
+		Rows:    make([]*grpc_api.WideRow, len(res.Rows)),
+	}
+	if res.Layout == models.SeriesLayoutWide {
+		apiRes.Layout = grpc_api.SeriesLayout_SERIES_LAYOUT_WIDE
+	}
+
+	for i, series := range res.Series {
+		points := make([]*grpc_api.SeriesPoint, len(series.Points))
+		for j, point := range series.Points {
+			points[j] = &grpc_api.SeriesPoint{Time: timestamppb.New(point.Time), Value: point.Value}
+		}
+
+		apiRes.Series[i] = &grpc_api.Series{SensorName: series.SensorName, Unit: series.Unit, Points: points}
+	}
+
+	for i, row := range res.Rows {
+		values := make([]*structpb.Value, len(row.Values))
+		for j, value := range row.Values {
+			if value != nil {
+				values[j] = structpb.NewNumberValue(*value)
+			} else {
+				values[j] = structpb.NewNullValue()
+			}
+		}
+
+		apiRes.Rows[i] = &grpc_api.WideRow{Time: timestamppb.New(row.Time), Values: values}
+	}
+
+	return apiRes
+}
+
+var apiFillStrategyToModel = map[grpc_api.FillStrategy]models.FillStrategy{
+	grpc_api.FillStrategy_FILL_STRATEGY_UNSPECIFIED: models.FillNull,
+	grpc_api.FillStrategy_FILL_STRATEGY_NULL:        models.FillNull,
+	grpc_api.FillStrategy_FILL_STRATEGY_LOCF:        models.FillLOCF,
+	grpc_api.FillStrategy_FILL_STRATEGY_LINEAR:      models.FillLinear,
+	grpc_api.FillStrategy_FILL_STRATEGY_CONSTANT:    models.FillConstant,
+}
+
+var apiInterpolationMethodToModel = map[grpc_api.InterpolationMethod]models.InterpolationMethod{
+	grpc_api.InterpolationMethod_INTERPOLATION_METHOD_UNSPECIFIED: models.InterpolationIDW,
+	grpc_api.InterpolationMethod_INTERPOLATION_METHOD_IDW:         models.InterpolationIDW,
+	grpc_api.InterpolationMethod_INTERPOLATION_METHOD_NEAREST:     models.InterpolationNearest,
+	grpc_api.InterpolationMethod_INTERPOLATION_METHOD_KRIGING:     models.InterpolationKriging,
+}
+
+var modelInterpolationMethodToAPI = map[models.InterpolationMethod]grpc_api.InterpolationMethod{
+	models.InterpolationIDW:     grpc_api.InterpolationMethod_INTERPOLATION_METHOD_IDW,
+	models.InterpolationNearest: grpc_api.InterpolationMethod_INTERPOLATION_METHOD_NEAREST,
+	models.InterpolationKriging: grpc_api.InterpolationMethod_INTERPOLATION_METHOD_KRIGING,
+}
+
+// apiRetentionScopeToModel maps RETENTION_SCOPE_UNSPECIFIED to the empty scope, which validation rejects.
+var apiRetentionScopeToModel = map[grpc_api.RetentionScope]models.RetentionScope{
+	grpc_api.RetentionScope_RETENTION_SCOPE_GLOBAL: models.RetentionScopeGlobal,
+	grpc_api.RetentionScope_RETENTION_SCOPE_TAG:    models.RetentionScopeTag,
+	grpc_api.RetentionScope_RETENTION_SCOPE_SENSOR: models.RetentionScopeSensor,
+}
+
+var modelRetentionScopeToAPI = map[models.RetentionScope]grpc_api.RetentionScope{
+	models.RetentionScopeGlobal: grpc_api.RetentionScope_RETENTION_SCOPE_GLOBAL,
+	models.RetentionScopeTag:    grpc_api.RetentionScope_RETENTION_SCOPE_TAG,
+	models.RetentionScopeSensor: grpc_api.RetentionScope_RETENTION_SCOPE_SENSOR,
+}
+
+func modelRetentionPolicyToAPI(policy *models.RetentionPolicy) *grpc_api.RetentionPolicy {
+	return &grpc_api.RetentionPolicy{
+		Scope:  modelRetentionScopeToAPI[policy.Scope],
+		Target: policy.Target,
+		MaxAge: durationpb.New(time.Duration(policy.MaxAge)),
+	}
+}
+
+func modelCompressionSettingsToAPI(settings *models.CompressionSettings) *grpc_api.CompressionSettings {
+	res := &grpc_api.CompressionSettings{
+		Enabled:   settings.Enabled,
+		SegmentBy: settings.SegmentBy,
+		OrderBy:   settings.OrderBy,
+	}
+	if settings.CompressAfter > 0 {
+		res.CompressAfter = durationpb.New(time.Duration(settings.CompressAfter))
+	}
+
+	return res
+}
+
+func modelSensorsToAPI(sensors []*models.Sensor) []*grpc_api.Sensor {
+	apiSensors := make([]*grpc_api.Sensor, len(sensors))
+	for i, sensor := range sensors {
+		apiSensors[i] = modelSensorToAPI(sensor)
+	}
+	return apiSensors
+}
+
+func apiListSensorsRequestToModel(in *grpc_api.ListSensorsRequest) models.ListSensorsQuery {
+	query := models.ListSensorsQuery{
+		PageSize:   int(in.PageSize),
+		PageToken:  in.PageToken,
+		NamePrefix: in.NamePrefix,
+		AnyTags:    in.AnyTags,
+		AllTags:    in.AllTags,
+		Order:      models.SortOrderAsc,
+	}
+	if in.Order == grpc_api.SortOrder_SORT_ORDER_DESC {
+		query.Order = models.SortOrderDesc
+	}
+	return query
+}
+
+func apiBoundingBoxToModel(in *grpc_api.BoundingBox) *models.BoundingBox {
+	return &models.BoundingBox{
+		MinLongitude: in.MinLongitude,
+		MinLatitude:  in.MinLatitude,
+		MaxLongitude: in.MaxLongitude,
+		MaxLatitude:  in.MaxLatitude,
 	}
 }
 
@@ -216,11 +1143,17 @@ func apiLocationToModel(in *grpc_api.Location) *models.Location {
 }
 
 func apiReadingToModel(in *grpc_api.SensorReading) *models.SensorReading {
-	return &models.SensorReading{
+	reading := &models.SensorReading{
 		SensorName: in.SensorName,
 		Value:      in.Value,
-		Time:       in.Time.AsTime(),
+		Quality:    apiQualityToModel[in.Quality],
+		Annotation: in.Annotation,
+	}
+	// an absent timestamp stays zero so the database falls back to server time
+	if in.Time != nil {
+		reading.Time = in.Time.AsTime()
 	}
+	return reading
 }
 
 func modelReadingToAPI(reading *models.SensorReading) *grpc_api.SensorReading {
@@ -228,15 +1161,57 @@ func modelReadingToAPI(reading *models.SensorReading) *grpc_api.SensorReading {
 		SensorName: reading.SensorName,
 		Value:      reading.Value,
 		Time:       timestamppb.New(reading.Time),
+		Unit:       reading.Unit,
+		OutOfRange: reading.OutOfRange,
+		Quality:    modelQualityToAPI[reading.Quality],
+		Annotation: reading.Annotation,
 	}
 }
 
+// apiQualityToModel leaves an unspecified quality empty so that the database picks the default.
+var apiQualityToModel = map[grpc_api.Quality]models.Quality{
+	grpc_api.Quality_QUALITY_GOOD:      models.QualityGood,
+	grpc_api.Quality_QUALITY_SUSPECT:   models.QualitySuspect,
+	grpc_api.Quality_QUALITY_BAD:       models.QualityBad,
+	grpc_api.Quality_QUALITY_ESTIMATED: models.QualityEstimated,
+}
+
+var modelQualityToAPI = map[models.Quality]grpc_api.Quality{
+	models.QualityGood:      grpc_api.Quality_QUALITY_GOOD,
+	models.QualitySuspect:   grpc_api.Quality_QUALITY_SUSPECT,
+	models.QualityBad:       grpc_api.Quality_QUALITY_BAD,
+	models.QualityEstimated: grpc_api.Quality_QUALITY_ESTIMATED,
+}
+
+func modelBatchResponseToAPI(res *models.BatchSensorReadingsResponse) *grpc_api.CreateSensorReadingsResponse {
+	apiRes := &grpc_api.CreateSensorReadingsResponse{
+		Accepted: int32(res.Accepted),
+		Rejected: int32(res.Rejected),
+		Results:  make([]*grpc_api.ReadingResult, len(res.Results)),
+	}
+	for i, result := range res.Results {
+		apiRes.Results[i] = &grpc_api.ReadingResult{Index: int32(result.Index), Error: result.Error}
+		if result.Reading != nil {
+			apiRes.Results[i].Reading = modelReadingToAPI(result.Reading)
+		}
+	}
+	return apiRes
+}
+
 func apiTimeRangeQueryToModel(in *grpc_api.TimeRangeQuery) models.TimeRangeQuery {
-	return models.TimeRangeQuery{
+	query := models.TimeRangeQuery{
 		SensorName: in.SensorName,
 		StartTime:  in.StartTime.AsTime(),
 		EndTime:    in.EndTime.AsTime(),
+		PageSize:   int(in.PageSize),
+		PageToken:  in.PageToken,
+		Order:      models.SortOrderAsc,
+		GoodOnly:   in.GoodOnly,
+	}
+	if in.Order == grpc_api.SortOrder_SORT_ORDER_DESC {
+		query.Order = models.SortOrderDesc
 	}
+	return query
 }
 
 func modelReadingsToAPI(readings []*models.SensorReading) []*grpc_api.SensorReading {
@@ -247,6 +1222,45 @@ func modelReadingsToAPI(readings []*models.SensorReading) []*grpc_api.SensorRead
 	return apiReadings
 }
 
+// apiAggregationQueryToModel leaves missing timestamps zero so that validation reports them.
+func apiAggregationQueryToModel(in *grpc_api.AggregationQuery) models.AggregationQuery {
+	query := models.AggregationQuery{
+		SensorName:  in.SensorName,
+		Tags:        in.Tags,
+		BucketWidth: models.Duration(in.BucketWidth.AsDuration()),
+		Functions:   make([]models.AggregateFunction, len(in.Functions)),
+		GoodOnly:    in.GoodOnly,
+	}
+
+	if in.StartTime != nil {
+		query.StartTime = in.StartTime.AsTime()
+	}
+
+	if in.EndTime != nil {
+		query.EndTime = in.EndTime.AsTime()
+	}
+
+	for i, function := range in.Functions {
+		query.Functions[i] = models.AggregateFunction(function)
+	}
+
+	return query
+}
+
+func modelBucketsToAPI(buckets []*models.AggregateBucket) []*grpc_api.AggregateBucket {
+	apiBuckets := make([]*grpc_api.AggregateBucket, len(buckets))
+	for i, bucket := range buckets {
+		values := make(map[string]float64, len(bucket.Values))
+		for function, value := range bucket.Values {
+			values[string(function)] = value
+		}
+
+		apiBuckets[i] = &grpc_api.AggregateBucket{Time: timestamppb.New(bucket.Time), Values: values}
+	}
+
+	return apiBuckets
+}
+
 func authenticate(ctx context.Context) (context.Context, error) {
 	if peer, ok := peer2.FromContext(ctx); !ok {
 		return ctx, status.New(codes.Unknown, "couldn't find peer info").Err()
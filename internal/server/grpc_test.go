@@ -1 +1,328 @@
 package server_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc_api "github.com/koneal2013/sensorsphere/api/v1/grpc"
+	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/models"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
+	"github.com/koneal2013/sensorsphere/internal/server"
+)
+
+// newGrpcClient serves a Grpc server with config over an in-memory listener and returns a client for it
+func newGrpcClient(t *testing.T, config *server.GrpcConfig) grpc_api.SensorSphereServiceClient {
+	t.Helper()
+
+	ln := bufconn.Listen(1024 * 1024)
+
+	gsrv, err := server.NewGRPCServer(config)
+	require.NoError(t, err)
+
+	go func() {
+		_ = gsrv.Serve(ln)
+	}()
+	t.Cleanup(gsrv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return ln.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return grpc_api.NewSensorSphereServiceClient(conn)
+}
+
+func TestStreamSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a Grpc server that writes every two readings and never flushes on a timer
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB, StreamBatchSize: 2, StreamFlushInterval: time.Hour})
+
+	// Setup expectations: the first batch is written in full, the second has an unknown sensor and the last
+	// reading is flushed when the stream closes
+	mockDB.On("CreateSensorReadings", mock.Anything, mock.MatchedBy(func(readings []*models.SensorReading) bool {
+		return len(readings) == 2 && readings[0].SensorName == "Test Sensor"
+	}), false).Return([]error{nil, nil}, nil).Once()
+	mockDB.On("CreateSensorReadings", mock.Anything, mock.MatchedBy(func(readings []*models.SensorReading) bool {
+		return len(readings) == 1 && readings[0].SensorName == "Unknown Sensor"
+	}), false).Return([]error{db.ErrSensorNotFound}, nil).Once()
+	mockDB.On("CreateSensorReadings", mock.Anything, mock.MatchedBy(func(readings []*models.SensorReading) bool {
+		return len(readings) == 1 && readings[0].SensorName == "Test Sensor"
+	}), false).Return([]error{nil}, nil).Once()
+
+	stream, err := client.StreamSensorReadings(context.Background())
+	require.NoError(t, err)
+
+	// Send the readings; the second batch also holds one without a value, which is rejected before the database
+	for _, reading := range []*grpc_api.SensorReading{
+		{SensorName: "Test Sensor", Value: 1.0},
+		{SensorName: "Test Sensor", Value: 2.0},
+		{SensorName: "Unknown Sensor", Value: 3.0},
+		{SensorName: "Test Sensor"},
+		{SensorName: "Test Sensor", Value: 5.0},
+	} {
+		require.NoError(t, stream.Send(reading))
+	}
+
+	summary, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	// Check the summary
+	require.Equal(t, int64(3), summary.Accepted)
+	require.Equal(t, int64(2), summary.Rejected)
+	require.Len(t, summary.Rejections, 2)
+	require.Equal(t, int32(2), summary.Rejections[0].Index)
+	require.Equal(t, db.ErrSensorNotFound.Error(), summary.Rejections[0].Error)
+	require.Equal(t, int32(3), summary.Rejections[1].Index)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestWatchSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a Grpc server with a hub to publish readings to
+	hub := pubsub.NewHub(mockDB)
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB, Hub: hub})
+
+	since := time.Now().Add(-1 * time.Hour).UTC().Truncate(time.Second)
+	stored := &models.SensorReading{SensorName: "Test Sensor", Value: 1.0, Time: since.Add(time.Minute)}
+
+	// Setup expectations: the reading stored since the requested time is replayed first
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.MatchedBy(func(q models.ExportQuery) bool {
+		return len(q.SensorNames) == 1 && q.SensorNames[0] == "Test Sensor" && q.StartTime.Equal(since)
+	}), mock.Anything).Return([]*models.SensorReading{stored}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchSensorReadings(ctx, &grpc_api.WatchSensorReadingsRequest{
+		SensorNames: []string{"Test Sensor"},
+		Since:       timestamppb.New(since),
+	})
+	require.NoError(t, err)
+
+	replayed, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, 1.0, replayed.Value)
+
+	// The server subscribed before replaying, so readings published now are streamed live
+	hub.Publish(ctx,
+		&models.SensorReading{SensorName: "Other Sensor", Value: 2.0},
+		&models.SensorReading{SensorName: "Test Sensor", Value: 3.0})
+
+	live, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "Test Sensor", live.SensorName)
+	require.Equal(t, 3.0, live.Value)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestWatchSensorReadingsPublishedDuringReplay(t *testing.T) {
+	// Create a Grpc server with a hub to publish readings to
+	mockDB := new(MockDb)
+	hub := pubsub.NewHub(mockDB)
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB, Hub: hub})
+
+	since := time.Now().Add(-1 * time.Hour).UTC().Truncate(time.Second)
+	stored := &models.SensorReading{SensorName: "Test Sensor", Value: 1.0, Time: since.Add(time.Minute)}
+
+	// Setup expectations: while the replay runs, the stored reading is published again along with a new one
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			hub.Publish(context.Background(),
+				&models.SensorReading{SensorName: "Test Sensor", Value: 1.0, Time: stored.Time},
+				&models.SensorReading{SensorName: "Test Sensor", Value: 2.0, Time: since.Add(time.Hour)})
+		}).
+		Return([]*models.SensorReading{stored}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchSensorReadings(ctx, &grpc_api.WatchSensorReadingsRequest{
+		SensorNames: []string{"Test Sensor"},
+		Since:       timestamppb.New(since),
+	})
+	require.NoError(t, err)
+
+	// The replayed reading comes first, then the new one, and the one both replayed and published only once
+	for _, value := range []float64{1.0, 2.0} {
+		reading, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, value, reading.Value)
+	}
+
+	hub.Publish(ctx, &models.SensorReading{SensorName: "Test Sensor", Value: 3.0})
+
+	live, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, 3.0, live.Value)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestWatchSensorReadingsHubClosed(t *testing.T) {
+	// Create a Grpc server with a hub to publish readings to
+	mockDB := new(MockDb)
+	hub := pubsub.NewHub(mockDB)
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB, Hub: hub})
+
+	stream, err := client.WatchSensorReadings(context.Background(), &grpc_api.WatchSensorReadingsRequest{
+		SensorNames: []string{"Test Sensor"},
+	})
+	require.NoError(t, err)
+
+	// Closing the hub, as the agent does on shutdown, ends the idle stream
+	hub.Close()
+
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestFindNearestSensors(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a Grpc server with the mock database
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB})
+
+	// Setup expectations: k defaults to one
+	mockDB.On("FindNearestSensors", mock.Anything, models.NearestQuery{
+		Longitude:      -0.1276,
+		Latitude:       51.5072,
+		K:              1,
+		ReportedWithin: models.Duration(30 * time.Minute),
+	}).Return([]*models.SensorDistance{
+		{
+			Sensor:   models.Sensor{Name: "Sensor A", Location: models.Location{Longitude: -0.13, Latitude: 51.51}},
+			Distance: 350.5,
+		},
+	}, nil)
+
+	res, err := client.FindNearestSensors(context.Background(), &grpc_api.FindNearestSensorsRequest{
+		Location:       &grpc_api.Location{Longitude: -0.1276, Latitude: 51.5072},
+		ReportedWithin: durationpb.New(30 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res.Sensors, 1)
+	require.Equal(t, "Sensor A", res.Sensors[0].Sensor.Name)
+	require.Equal(t, 350.5, res.Sensors[0].DistanceMeters)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestInterpolateSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a Grpc server with the mock database
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB})
+
+	at := time.Date(2023, 8, 25, 12, 0, 0, 0, time.UTC)
+
+	// Setup expectations: four sensors around the point at the same distance
+	mockDB.On("GetInterpolationSources", mock.Anything, mock.MatchedBy(func(q models.InterpolationQuery) bool {
+		return q.Method == models.InterpolationKriging && q.Time.Equal(at) && q.K == 4 && q.Range == 5000
+	})).Return([]*models.InterpolationSource{
+		{SensorName: "North", Location: models.Location{Longitude: 10, Latitude: 50.001}, Value: 10},
+		{SensorName: "South", Location: models.Location{Longitude: 10, Latitude: 49.999}, Value: 20},
+		{SensorName: "East", Location: models.Location{Longitude: 10.0015557, Latitude: 50}, Value: 30},
+		{SensorName: "West", Location: models.Location{Longitude: 9.9984443, Latitude: 50}, Value: 40},
+	}, nil)
+
+	rangeMeters := 5000.0
+	res, err := client.InterpolateSensorReadings(context.Background(), &grpc_api.InterpolateSensorReadingsRequest{
+		Location:    &grpc_api.Location{Longitude: 10, Latitude: 50},
+		Time:        timestamppb.New(at),
+		Method:      grpc_api.InterpolationMethod_INTERPOLATION_METHOD_KRIGING,
+		K:           4,
+		RangeMeters: &rangeMeters,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, grpc_api.InterpolationMethod_INTERPOLATION_METHOD_KRIGING, res.Method)
+	require.InDelta(t, 25, res.Value, 0.01)
+	require.NotNil(t, res.Variance)
+	require.Len(t, res.Sensors, 4)
+	for _, source := range res.Sensors {
+		require.InDelta(t, 0.25, source.Weight, 0.001)
+	}
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestExportSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a Grpc server with the mock database
+	client := newGrpcClient(t, &server.GrpcConfig{Db: mockDB})
+
+	startTime := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	readings := []*models.SensorReading{
+		{SensorName: "Test Sensor", Value: 1.0, Time: startTime},
+		{SensorName: "Test Sensor", Value: 2.0, Time: startTime.Add(time.Minute)},
+		{SensorName: "Test Sensor", Value: 3.0, Time: startTime.Add(2 * time.Minute)},
+	}
+
+	// Setup expectations: the readings are exported two at a time
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.MatchedBy(func(q models.ExportQuery) bool {
+		return len(q.SensorNames) == 1 && q.SensorNames[0] == "Test Sensor" && q.StartTime.Equal(startTime) &&
+			q.Order == models.SortOrderAsc
+	}), 2).Return(readings, nil)
+
+	stream, err := client.ExportSensorReadings(context.Background(), &grpc_api.ExportSensorReadingsRequest{
+		SensorName: "Test Sensor",
+		StartTime:  timestamppb.New(startTime),
+		EndTime:    timestamppb.New(startTime.Add(time.Hour)),
+		ChunkSize:  2,
+	})
+	require.NoError(t, err)
+
+	// Check that every chunk arrives as its own message
+	var chunks [][]float64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		var values []float64
+		for _, reading := range res.SensorReadings {
+			values = append(values, reading.Value)
+		}
+		chunks = append(chunks, values)
+	}
+
+	require.Equal(t, [][]float64{{1, 2}, {3}}, chunks)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
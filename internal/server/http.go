@@ -2,46 +2,103 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/interpolation"
 	"github.com/koneal2013/sensorsphere/internal/middleware/adaptor"
 	"github.com/koneal2013/sensorsphere/internal/models"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
 )
 
 type HttpConfig struct {
-	Port            int
-	MiddlewareFuncs []mux.MiddlewareFunc
-	Db              db.Database
+	Port              int
+	MiddlewareFuncs   []mux.MiddlewareFunc
+	Db                db.Database
+	ReadingTimeWindow ReadingTimeWindow
+	// Hub publishes stored readings to /sensor_readings/stream subscribers.
+	Hub *pubsub.Hub
+	// MaxQuerySeries caps how many sensors one /sensor_readings/series query may select (default 100).
+	MaxQuerySeries int
 }
 
 type SensorSphere struct {
-	HttpTracer trace.Tracer
-	database   db.Database
+	HttpTracer        trace.Tracer
+	database          db.Database
+	readingTimeWindow ReadingTimeWindow
+	hub               *pubsub.Hub
+	maxQuerySeries    int
 }
 
 func NewHTTPServer(cfg *HttpConfig) (*http.Server, error) {
 	s := &SensorSphere{
-		HttpTracer: otel.GetTracerProvider().Tracer("httpTracer"),
-		database:   cfg.Db,
+		HttpTracer:        otel.GetTracerProvider().Tracer("httpTracer"),
+		database:          cfg.Db,
+		readingTimeWindow: cfg.ReadingTimeWindow,
+		hub:               cfg.Hub,
+		maxQuerySeries:    cfg.MaxQuerySeries,
 	}
 	r := mux.NewRouter()
-	r.HandleFunc("/sensors", adaptor.GenericHttpAdaptor(s.HandleCreateSensor)).Methods(http.MethodPost)
-	r.HandleFunc("/sensors/nearest", adaptor.GenericHttpAdaptor(s.HandleGetNearestSensor)).Methods(http.MethodGet)
+	r.HandleFunc("/sensors",
+		geoJSONSensorBody(adaptor.GenericHttpAdaptor(s.HandleCreateSensor))).Methods(http.MethodPost)
+	r.HandleFunc("/sensors", adaptor.GenericHttpAdaptorWithEncoder(s.HandleListSensors,
+		sensorsEncoder(s, sensorPageCollection))).Methods(http.MethodGet)
+	r.HandleFunc("/sensors/nearest", adaptor.GenericHttpAdaptorWithEncoder(s.HandleGetNearestSensor,
+		sensorsEncoder(s, nearestSensorCollection))).Methods(http.MethodGet)
+	r.HandleFunc("/sensors:nearest", adaptor.GenericHttpAdaptorWithEncoder(s.HandleFindNearestSensors,
+		sensorsEncoder(s, nearestSensorsCollection))).Methods(http.MethodGet)
+	r.HandleFunc("/sensors/search/area", adaptor.GenericHttpAdaptorWithEncoder(s.HandleFindSensorsInArea,
+		sensorsEncoder(s, sensorPageCollection))).Methods(http.MethodPost)
+	r.HandleFunc("/sensors/within", adaptor.GenericHttpAdaptorWithEncoder(s.HandleFindSensorsWithinRadius,
+		sensorsEncoder(s, sensorDistancePageCollection))).Methods(http.MethodGet)
 	r.HandleFunc("/sensor_readings",
 		adaptor.GenericHttpAdaptor(s.HandleGetSensorReadingsForTimeRange)).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings:batch",
+		adaptor.GenericHttpAdaptor(s.HandleCreateSensorReadings)).Methods(http.MethodPost)
+	r.HandleFunc("/sensor_readings:flag",
+		adaptor.GenericHttpAdaptor(s.HandleFlagSensorReadings)).Methods(http.MethodPost)
+	r.HandleFunc("/sensor_readings:import", s.HandleImportSensorReadings).Methods(http.MethodPost)
+	r.HandleFunc("/sensor_readings/stream", s.HandleStreamSensorReadings).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings/export", s.HandleExportSensorReadings).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings/series",
+		adaptor.GenericHttpAdaptor(s.HandleGetSensorReadingSeries)).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings/latest",
+		adaptor.GenericHttpAdaptor(s.HandleGetLatestReadings)).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings:resample",
+		adaptor.GenericHttpAdaptor(s.HandleResampleSensorReadings)).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings:interpolate",
+		adaptor.GenericHttpAdaptor(s.HandleInterpolateSensorReadings)).Methods(http.MethodGet)
+	r.HandleFunc("/sensor_readings/aggregate",
+		adaptor.GenericHttpAdaptor(s.HandleAggregateSensorReadings)).Methods(http.MethodGet)
+	r.HandleFunc("/retention_policies",
+		adaptor.GenericHttpAdaptor(s.HandleListRetentionPolicies)).Methods(http.MethodGet)
+	r.HandleFunc("/retention_policies",
+		adaptor.GenericHttpAdaptor(s.HandleSetRetentionPolicy)).Methods(http.MethodPut)
+	r.HandleFunc("/retention_policies",
+		adaptor.GenericHttpAdaptor(s.HandleDeleteRetentionPolicy)).Methods(http.MethodDelete)
+	r.HandleFunc("/retention_policies:apply",
+		adaptor.GenericHttpAdaptor(s.HandleApplyRetention)).Methods(http.MethodPost)
+	r.HandleFunc("/compression",
+		adaptor.GenericHttpAdaptor(s.HandleGetCompressionSettings)).Methods(http.MethodGet)
+	r.HandleFunc("/compression", adaptor.GenericHttpAdaptor(s.HandleSetCompression)).Methods(http.MethodPut)
+	r.HandleFunc("/compression/chunks",
+		adaptor.GenericHttpAdaptor(s.HandleListChunkCompression)).Methods(http.MethodGet)
 	r.HandleFunc("/status", s.HandleStatus).Methods(http.MethodGet)
 	r.HandleFunc("/sensor_readings",
 		adaptor.GenericHttpAdaptor(s.HandleCreateSensorReading)).Methods(http.MethodPost)
 	r.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/",
 		http.FileServer(http.Dir("./cmd/sensorsphere/docs"))))
 	r.HandleFunc("/sensors/{name}", adaptor.GenericHttpAdaptor(s.HandleGetSensor)).Methods(http.MethodGet)
-	r.HandleFunc("/sensors/{name}", adaptor.GenericHttpAdaptor(s.HandleUpdateSensor)).Methods(http.MethodPut)
+	r.HandleFunc("/sensors/{name}",
+		geoJSONSensorBody(adaptor.GenericHttpAdaptor(s.HandleUpdateSensor))).Methods(http.MethodPut)
+	r.HandleFunc("/sensors/{name}", adaptor.GenericHttpAdaptor(s.HandleDeleteSensor)).Methods(http.MethodDelete)
 	r.Use(cfg.MiddlewareFuncs...)
 
 	return &http.Server{
@@ -62,12 +119,15 @@ func (s *SensorSphere) HandleStatus(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Create a new sensor
-// @Description Create a new sensor with the input payload
+// @Description Create a new sensor with the input payload, given as JSON or as a GeoJSON Feature with a Point
+// @Description geometry whose properties hold the other fields.
 // @Tags sensors
 // @Accept  json
+// @Accept  application/geo+json
 // @Produce  json
 // @Param sensor body models.Sensor true "Create sensor"
 // @Success 200 {object} models.Sensor
+// @Failure 409 {string} string "Sensor already exists or has been deleted"
 // @Router /sensors [post]
 func (s *SensorSphere) HandleCreateSensor(ctx context.Context, in models.Sensor) (*models.Sensor, error) {
 	ctx, span := s.HttpTracer.Start(ctx, "HandleCreateSensor")
@@ -77,8 +137,14 @@ func (s *SensorSphere) HandleCreateSensor(ctx context.Context, in models.Sensor)
 		return nil, fmt.Errorf("missing required fields")
 	}
 
+	if err := validateSensorRange(&in); err != nil {
+		return nil, err
+	}
+
 	sensor, err := s.database.CreateSensor(ctx, &in)
-	if err != nil {
+	if errors.Is(err, db.ErrSensorExists) || errors.Is(err, db.ErrSensorDeleted) {
+		return nil, adaptor.NewHttpError(http.StatusConflict, err)
+	} else if err != nil {
 		return &models.Sensor{}, err
 	}
 
@@ -103,23 +169,61 @@ func (s *SensorSphere) HandleGetSensor(ctx context.Context, in map[string]string
 	}
 
 	sensor, err := s.database.GetSensor(ctx, sensorName)
-	if err != nil {
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	} else if err != nil {
 		return nil, err
 	}
 
 	return sensor, nil
 }
 
+// @Summary List sensors
+// @Description List sensors a page at a time, optionally filtered by name prefix and tags.
+// @Description Pass the returned nextPageToken as pageToken to fetch the following page. Clients accepting
+// @Description application/geo+json get a FeatureCollection with the latest value of each sensor.
+// @Tags sensors
+// @Produce  json
+// @Produce  application/geo+json
+// @Param pageSize query int false "Maximum number of sensors to return (default 100, max 1000)"
+// @Param pageToken query string false "Token from a previous page"
+// @Param namePrefix query string false "Only sensors whose name starts with this prefix"
+// @Param anyTags query []string false "Only sensors with at least one of these tags" collectionFormat(multi)
+// @Param allTags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param order query string false "Sort order by name" Enums(asc, desc)
+// @Success 200 {object} models.SensorPage
+// @Router /sensors [get]
+func (s *SensorSphere) HandleListSensors(ctx context.Context, in models.ListSensorsQuery) (*models.SensorPage, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleListSensors")
+	defer span.End()
+
+	if in.Order != "" && in.Order != models.SortOrderAsc && in.Order != models.SortOrderDesc {
+		return nil, fmt.Errorf("invalid sort order %q", in.Order)
+	}
+
+	page, err := s.database.ListSensors(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
 // @Summary Get sensor readings for a time range
-// @Description Get sensor readings for a specific time range
+// @Description Get a sensor's readings between startTime and endTime a page at a time, ordered by time.
+// @Description Pass the returned nextPageToken as pageToken to fetch the following page.
 // @Tags sensor_readings
 // @Accept  json
 // @Produce  json
 // @Param timeRangeQuery body models.TimeRangeQuery true "Time range query"
-// @Success 200 {array} models.SensorReading
+// @Param pageSize query int false "Maximum number of readings to return (default 1000, max 10000)"
+// @Param pageToken query string false "Token from a previous page"
+// @Param order query string false "Sort order by time" Enums(asc, desc)
+// @Param goodOnly query bool false "Leave out readings whose quality is not good"
+// @Success 200 {object} models.SensorReadingPage
 // @Router /sensor_readings [get]
 func (s *SensorSphere) HandleGetSensorReadingsForTimeRange(ctx context.Context,
-	in models.TimeRangeQuery) ([]*models.SensorReading, error) {
+	in models.TimeRangeQuery) (*models.SensorReadingPage, error) {
 	ctx, span := s.HttpTracer.Start(ctx, "HandleGetSensorReadingsForTimeRange")
 	defer span.End()
 
@@ -127,18 +231,201 @@ func (s *SensorSphere) HandleGetSensorReadingsForTimeRange(ctx context.Context,
 		return nil, fmt.Errorf("missing required fields")
 	}
 
-	sensorReadings, err := s.database.GetSensorReadingsForTimeRange(ctx, in)
+	if in.Order != "" && in.Order != models.SortOrderAsc && in.Order != models.SortOrderDesc {
+		return nil, fmt.Errorf("invalid sort order %q", in.Order)
+	}
+
+	page, err := s.database.GetSensorReadingsForTimeRange(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// @Summary Get readings of several sensors
+// @Description Get the readings between startTime and endTime of the named sensors and/or of the sensors
+// @Description matching the tag filters, either grouped per sensor or as a wide table with a row per timestamp
+// @Description and a column per sensor. Queries selecting too many sensors or readings are rejected.
+// @Tags sensor_readings
+// @Produce  json
+// @Param sensorNames query []string false "Sensor names" collectionFormat(multi)
+// @Param anyTags query []string false "Sensors with at least one of these tags" collectionFormat(multi)
+// @Param allTags query []string false "Sensors with all of these tags" collectionFormat(multi)
+// @Param startTime query string true "Start of the range (RFC 3339)"
+// @Param endTime query string true "End of the range (RFC 3339)"
+// @Param layout query string false "Response layout (default grouped)" Enums(grouped, wide)
+// @Param goodOnly query bool false "Leave out readings whose quality is not good"
+// @Success 200 {object} models.SeriesResponse
+// @Router /sensor_readings/series [get]
+func (s *SensorSphere) HandleGetSensorReadingSeries(ctx context.Context,
+	in models.SeriesQuery) (*models.SeriesResponse, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleGetSensorReadingSeries")
+	defer span.End()
+
+	if err := validateSeriesQuery(&in); err != nil {
+		return nil, err
+	}
+
+	series, err := s.database.GetSensorReadingSeries(ctx, in, maxQuerySeriesOrDefault(s.maxQuerySeries),
+		maxSeriesReadings)
+	if err != nil {
+		return nil, err
+	}
+
+	return layoutSeries(in.Layout, series), nil
+}
+
+// @Summary Get the latest readings
+// @Description Get the most recent reading of each of the named sensors and/or of every sensor carrying all of
+// @Description the given tags. Sensors without readings are left out.
+// @Tags sensor_readings
+// @Produce  json
+// @Param sensorNames query []string false "Sensor names (at most 1000)" collectionFormat(multi)
+// @Param tags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param goodOnly query bool false "Return the latest good reading of each sensor"
+// @Success 200 {array} models.SensorReading
+// @Router /sensor_readings/latest [get]
+func (s *SensorSphere) HandleGetLatestReadings(ctx context.Context,
+	in models.LatestReadingsQuery) ([]*models.SensorReading, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleGetLatestReadings")
+	defer span.End()
+
+	if err := validateLatestReadingsQuery(in); err != nil {
+		return nil, err
+	}
+
+	readings, err := s.database.GetLatestReadings(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
+
+// @Summary Resample sensor readings
+// @Description Align a sensor's readings in [startTime, endTime) to one point every interval, averaging the
+// @Description readings within an interval. Intervals without readings are filled with null, the last observed
+// @Description value (locf), a linear interpolation or fillValue, and flagged as synthesized.
+// @Tags sensor_readings
+// @Produce  json
+// @Param sensorName query string true "Sensor name"
+// @Param startTime query string true "Start of the range (RFC 3339), inclusive"
+// @Param endTime query string true "End of the range (RFC 3339), exclusive"
+// @Param interval query string true "Spacing of the points as a duration such as 5m"
+// @Param fill query string false "Gap filling strategy (default null)" Enums(null, locf, linear, constant)
+// @Param fillValue query number false "Value of unfilled points with the constant strategy"
+// @Param goodOnly query bool false "Resample good readings only"
+// @Success 200 {array} models.ResampledReading
+// @Failure 404 {string} string "Sensor not found"
+// @Router /sensor_readings:resample [get]
+func (s *SensorSphere) HandleResampleSensorReadings(ctx context.Context,
+	in models.ResampleQuery) ([]*models.ResampledReading, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleResampleSensorReadings")
+	defer span.End()
+
+	if err := validateResampleQuery(&in); err != nil {
+		return nil, err
+	}
+
+	readings, err := s.database.ResampleSensorReadings(ctx, in)
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
+
+// @Summary Interpolate the value at a point
+// @Description Estimate the value at a point and time from the readings of the k nearest sensors that have a
+// @Description reading within window of the time, using the reading of each closest to the time. Returns the
+// @Description estimate and the sensors it was made from, each with its distance, reading and weight.
+// @Tags sensor_readings
+// @Produce  json
+// @Param longitude query number true "Longitude of the point"
+// @Param latitude query number true "Latitude of the point"
+// @Param time query string false "Time of the estimate (RFC 3339), now by default"
+// @Param method query string false "Interpolation method (default idw)" Enums(idw, nearest, kriging)
+// @Param k query int false "Number of sensors to interpolate from (default 8, max 100)"
+// @Param window query string false "How far from the time readings are used, as a duration (default 15m)"
+// @Param maxDistance query number false "Only sensors within this many metres"
+// @Param measurementType query string false "Only sensors measuring this"
+// @Param anyTags query []string false "Only sensors with at least one of these tags" collectionFormat(multi)
+// @Param allTags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param goodOnly query bool false "Leave out readings whose quality is not good"
+// @Param power query number false "Inverse distance weighting exponent (default 2, max 10)"
+// @Param range query number false "Kriging practical range in metres (default the furthest the sensors are apart)"
+// @Success 200 {object} models.Interpolation
+// @Failure 404 {string} string "No readings near the point and time"
+// @Failure 422 {string} string "Sensors report in different units or share a location"
+// @Router /sensor_readings:interpolate [get]
+func (s *SensorSphere) HandleInterpolateSensorReadings(ctx context.Context,
+	in models.InterpolationQuery,
+) (*models.Interpolation, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleInterpolateSensorReadings")
+	defer span.End()
+
+	if err := validateInterpolationQuery(&in); err != nil {
+		return nil, err
+	}
+
+	sources, err := s.database.GetInterpolationSources(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 
-	return sensorReadings, nil
+	result, err := interpolate(in, sources)
+	switch {
+	case errors.Is(err, errNoInterpolationSources):
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	case errors.Is(err, errMixedUnits), errors.Is(err, interpolation.ErrCoincidentSamples):
+		return nil, adaptor.NewHttpError(http.StatusUnprocessableEntity, err)
+	case err != nil:
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// @Summary Aggregate sensor readings
+// @Description Summarise the readings in [startTime, endTime) in buckets of bucketWidth, for one sensor or for
+// @Description every sensor carrying all of the given tags. Buckets without readings are left out.
+// @Tags sensor_readings
+// @Produce  json
+// @Param sensorName query string false "Sensor name"
+// @Param tags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param startTime query string true "Start of the range (RFC 3339), inclusive"
+// @Param endTime query string true "End of the range (RFC 3339), exclusive"
+// @Param bucketWidth query string true "Bucket width as a duration such as 15m or 1h"
+// @Param functions query []string false "Aggregates (default avg)" collectionFormat(multi) Enums(avg, min, max, sum, count, first, last, stddev)
+// @Param goodOnly query bool false "Aggregate good readings only, always from the raw readings"
+// @Success 200 {array} models.AggregateBucket
+// @Router /sensor_readings/aggregate [get]
+func (s *SensorSphere) HandleAggregateSensorReadings(ctx context.Context,
+	in models.AggregationQuery) ([]*models.AggregateBucket, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleAggregateSensorReadings")
+	defer span.End()
+
+	if err := validateAggregationQuery(&in); err != nil {
+		return nil, err
+	}
+
+	buckets, err := s.database.AggregateSensorReadings(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
 }
 
 // @Summary Update a sensor
-// @Description Update a sensor with the input payload
+// @Description Update a sensor with the input payload, given as JSON or as a GeoJSON Feature with a Point
+// @Description geometry whose properties hold the other fields.
 // @Tags sensors
 // @Accept  json
+// @Accept  application/geo+json
 // @Produce  json
 // @Param sensor body models.Sensor true "Update sensor"
 // @Success 200 {integer} int64
@@ -151,6 +438,10 @@ func (s *SensorSphere) HandleUpdateSensor(ctx context.Context, in models.Sensor)
 		return 0, fmt.Errorf("missing required fields")
 	}
 
+	if err := validateSensorRange(&in); err != nil {
+		return 0, err
+	}
+
 	rows, err := s.database.UpdateSensor(ctx, &in)
 	if err != nil {
 		return 0, err
@@ -159,13 +450,54 @@ func (s *SensorSphere) HandleUpdateSensor(ctx context.Context, in models.Sensor)
 	return rows, nil
 }
 
+// @Summary Delete a sensor
+// @Description Delete a sensor by its name. Mode "restrict" (default) refuses when the sensor has readings,
+// @Description "cascade" deletes its readings too and "soft" hides the sensor but keeps its readings.
+// @Tags sensors
+// @Produce  json
+// @Param name path string true "Sensor name"
+// @Param mode query string false "Delete mode" Enums(restrict, cascade, soft)
+// @Success 200 {object} models.DeleteSensorResponse
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 409 {string} string "Sensor has readings"
+// @Router /sensors/{name} [delete]
+func (s *SensorSphere) HandleDeleteSensor(ctx context.Context,
+	in models.DeleteSensorRequest,
+) (*models.DeleteSensorResponse, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleDeleteSensor")
+	defer span.End()
+
+	if in.Name == "" {
+		return nil, fmt.Errorf("missing required fields")
+	}
+
+	if in.Mode == "" {
+		in.Mode = models.DeleteModeRestrict
+	}
+
+	readingsDeleted, err := s.database.DeleteSensor(ctx, in.Name, in.Mode)
+	switch {
+	case errors.Is(err, db.ErrSensorNotFound):
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	case errors.Is(err, db.ErrSensorHasReadings):
+		return nil, adaptor.NewHttpError(http.StatusConflict, err)
+	case err != nil:
+		return nil, err
+	}
+
+	return &models.DeleteSensorResponse{Name: in.Name, Mode: in.Mode, ReadingsDeleted: readingsDeleted}, nil
+}
+
 // @Summary Get the nearest sensor
-// @Description Get the nearest sensor to a specific location
+// @Description Get the nearest sensor to a specific location. Clients accepting application/geo+json get a
+// @Description FeatureCollection holding the sensor with the latest value it reported.
 // @Tags sensors
 // @Accept  json
 // @Produce  json
+// @Produce  application/geo+json
 // @Param location body models.Location true "Location"
 // @Success 200 {object} models.Sensor
+// @Failure 404 {string} string "Sensor not found"
 // @Router /sensors/nearest [get]
 func (s *SensorSphere) HandleGetNearestSensor(ctx context.Context, in models.Location) (*models.Sensor, error) {
 	ctx, span := s.HttpTracer.Start(ctx, "HandleGetNearestSensor")
@@ -176,20 +508,118 @@ func (s *SensorSphere) HandleGetNearestSensor(ctx context.Context, in models.Loc
 	}
 
 	sensor, err := s.database.GetNearestSensor(ctx, &in)
-	if err != nil {
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	} else if err != nil {
 		return &models.Sensor{}, err
 	}
 
 	return sensor, nil
 }
 
+// @Summary Find the k nearest sensors
+// @Description List the k sensors nearest a point, nearest first, each with its distance in metres. Sensors
+// @Description further away than maxDistance metres, without the tags asked for, or whose latest reading is
+// @Description older than reportedWithin are left out. Clients accepting application/geo+json get a
+// @Description FeatureCollection with the latest value of each sensor.
+// @Tags sensors
+// @Produce  json
+// @Produce  application/geo+json
+// @Param longitude query number true "Longitude of the point"
+// @Param latitude query number true "Latitude of the point"
+// @Param k query int false "Number of sensors to return (default 1, max 1000)"
+// @Param maxDistance query number false "Only sensors within this many metres"
+// @Param anyTags query []string false "Only sensors with at least one of these tags" collectionFormat(multi)
+// @Param allTags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param reportedWithin query string false "Only sensors with a reading this recent, as a duration such as 15m"
+// @Success 200 {object} models.NearestSensorsResponse
+// @Router /sensors:nearest [get]
+func (s *SensorSphere) HandleFindNearestSensors(ctx context.Context,
+	in models.NearestQuery,
+) (*models.NearestSensorsResponse, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleFindNearestSensors")
+	defer span.End()
+
+	if err := validateNearestQuery(&in); err != nil {
+		return nil, err
+	}
+
+	sensors, err := s.database.FindNearestSensors(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NearestSensorsResponse{Sensors: sensors}, nil
+}
+
+// @Summary Find sensors within a radius
+// @Description List the sensors within radius metres of a point a page at a time, nearest first, each with its
+// @Description distance in metres. Pass the returned nextPageToken as pageToken to fetch the following page.
+// @Description Clients accepting application/geo+json get a FeatureCollection with the latest value of each
+// @Description sensor.
+// @Tags sensors
+// @Produce  json
+// @Produce  application/geo+json
+// @Param longitude query number true "Longitude of the point"
+// @Param latitude query number true "Latitude of the point"
+// @Param radius query number true "Radius in metres"
+// @Param anyTags query []string false "Only sensors with at least one of these tags" collectionFormat(multi)
+// @Param allTags query []string false "Only sensors with all of these tags" collectionFormat(multi)
+// @Param pageSize query int false "Maximum number of sensors to return (default 100, max 1000)"
+// @Param pageToken query string false "Token from a previous page"
+// @Success 200 {object} models.SensorDistancePage
+// @Router /sensors/within [get]
+func (s *SensorSphere) HandleFindSensorsWithinRadius(ctx context.Context,
+	in models.RadiusQuery,
+) (*models.SensorDistancePage, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleFindSensorsWithinRadius")
+	defer span.End()
+
+	if err := validateRadiusQuery(in); err != nil {
+		return nil, err
+	}
+
+	return s.database.FindSensorsWithinRadius(ctx, in)
+}
+
+// @Summary Find sensors in an area
+// @Description List the sensors inside a bounding box or a GeoJSON Polygon or MultiPolygon geometry (or a
+// @Description Feature holding one), boundary included, a page at a time ordered by name. Areas are taken to be
+// @Description drawn on the longitude/latitude plane, and a bounding box whose minLongitude is greater than its
+// @Description maxLongitude crosses the antimeridian. Pass the returned nextPageToken as pageToken to fetch the
+// @Description following page. Clients accepting application/geo+json get a FeatureCollection with the latest
+// @Description value of each sensor.
+// @Tags sensors
+// @Accept  json
+// @Produce  json
+// @Produce  application/geo+json
+// @Param area body models.AreaQuery true "Area to search"
+// @Success 200 {object} models.SensorPage
+// @Router /sensors/search/area [post]
+func (s *SensorSphere) HandleFindSensorsInArea(ctx context.Context,
+	in models.AreaQuery,
+) (*models.SensorPage, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleFindSensorsInArea")
+	defer span.End()
+
+	if err := validateAreaQuery(&in); err != nil {
+		return nil, err
+	}
+
+	return s.database.FindSensorsInArea(ctx, in)
+}
+
 // @Summary Create a new sensor reading
-// @Description Create a new sensor reading with the input payload
+// @Description Create a new sensor reading with the input payload. The reading is stored at the supplied time,
+// @Description or at server time when none is given; times outside the configured window are rejected.
 // @Tags sensor_readings
 // @Accept  json
 // @Produce  json
 // @Param sensorReading body models.SensorReading true "Create sensor reading"
 // @Success 200 {object} models.SensorReading
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 409 {string} string "Sensor already has a reading at this time"
+// @Failure 422 {string} string "Reading time is outside the accepted window, or value outside the sensor's range"
 // @Router /sensor_readings [post]
 func (s *SensorSphere) HandleCreateSensorReading(ctx context.Context,
 	reading models.SensorReading,
@@ -201,10 +631,205 @@ func (s *SensorSphere) HandleCreateSensorReading(ctx context.Context,
 		return nil, fmt.Errorf("missing required fields")
 	}
 
+	if err := s.readingTimeWindow.Check(reading.Time, time.Now()); err != nil {
+		return nil, adaptor.NewHttpError(http.StatusUnprocessableEntity, err)
+	}
+
+	if err := validateQuality(reading.Quality); err != nil {
+		return nil, err
+	}
+
 	sensorReading, err := s.database.CreateSensorReading(ctx, &reading)
-	if err != nil {
+	switch {
+	case errors.Is(err, db.ErrSensorNotFound):
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	case errors.Is(err, db.ErrDuplicateReading):
+		return nil, adaptor.NewHttpError(http.StatusConflict, err)
+	case errors.Is(err, db.ErrValueOutOfRange):
+		return nil, adaptor.NewHttpError(http.StatusUnprocessableEntity, err)
+	case err != nil:
 		return nil, err
 	}
 
 	return sensorReading, nil
 }
+
+// @Summary Create sensor readings in bulk
+// @Description Create up to 10000 sensor readings in one request. With atomic set, either every reading is
+// @Description stored or none is; otherwise valid readings are stored and invalid ones reported per item.
+// @Tags sensor_readings
+// @Accept  json
+// @Produce  json
+// @Param batch body models.BatchSensorReadingsRequest true "Sensor readings"
+// @Success 200 {object} models.BatchSensorReadingsResponse
+// @Router /sensor_readings:batch [post]
+func (s *SensorSphere) HandleCreateSensorReadings(ctx context.Context,
+	in models.BatchSensorReadingsRequest,
+) (*models.BatchSensorReadingsResponse, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleCreateSensorReadings")
+	defer span.End()
+
+	return createSensorReadings(ctx, s.database, s.readingTimeWindow, in)
+}
+
+// @Summary Flag sensor readings
+// @Description Set the quality (good, suspect, bad or estimated) and annotation of a sensor's readings between
+// @Description startTime and endTime (both inclusive), e.g. after reviewing them.
+// @Tags sensor_readings
+// @Accept  json
+// @Produce  json
+// @Param flag body models.FlagReadingsRequest true "Readings to flag"
+// @Success 200 {object} models.FlagReadingsResponse
+// @Failure 404 {string} string "Sensor not found"
+// @Router /sensor_readings:flag [post]
+func (s *SensorSphere) HandleFlagSensorReadings(ctx context.Context,
+	in models.FlagReadingsRequest,
+) (*models.FlagReadingsResponse, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleFlagSensorReadings")
+	defer span.End()
+
+	if err := validateFlagReadingsRequest(in); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.database.FlagSensorReadings(ctx, in)
+	if errors.Is(err, db.ErrSensorNotFound) {
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &models.FlagReadingsResponse{Updated: updated}, nil
+}
+
+// @Summary List retention policies
+// @Description List the global, per-tag and per-sensor retention policies.
+// @Tags retention
+// @Produce  json
+// @Success 200 {array} models.RetentionPolicy
+// @Router /retention_policies [get]
+func (s *SensorSphere) HandleListRetentionPolicies(ctx context.Context,
+	_ struct{},
+) ([]*models.RetentionPolicy, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleListRetentionPolicies")
+	defer span.End()
+
+	return s.database.ListRetentionPolicies(ctx)
+}
+
+// @Summary Set a retention policy
+// @Description Create or replace the retention policy for a scope and target. A sensor's readings are kept
+// @Description for the maxAge of its sensor policy, else the longest of its tag policies, else the global policy.
+// @Tags retention
+// @Accept  json
+// @Produce  json
+// @Param policy body models.RetentionPolicy true "Retention policy"
+// @Success 200 {object} models.RetentionPolicy
+// @Router /retention_policies [put]
+func (s *SensorSphere) HandleSetRetentionPolicy(ctx context.Context,
+	in models.RetentionPolicy,
+) (*models.RetentionPolicy, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleSetRetentionPolicy")
+	defer span.End()
+
+	if err := validateRetentionPolicy(&in); err != nil {
+		return nil, err
+	}
+
+	return s.database.SetRetentionPolicy(ctx, &in)
+}
+
+// @Summary Delete a retention policy
+// @Description Delete the retention policy for a scope and target.
+// @Tags retention
+// @Produce  json
+// @Param scope query string true "Policy scope" Enums(global, tag, sensor)
+// @Param target query string false "Tag or sensor name"
+// @Success 200 {object} models.DeleteRetentionPolicyRequest
+// @Failure 404 {string} string "Retention policy not found"
+// @Router /retention_policies [delete]
+func (s *SensorSphere) HandleDeleteRetentionPolicy(ctx context.Context,
+	in models.DeleteRetentionPolicyRequest,
+) (*models.DeleteRetentionPolicyRequest, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleDeleteRetentionPolicy")
+	defer span.End()
+
+	err := s.database.DeleteRetentionPolicy(ctx, in.Scope, in.Target)
+	if errors.Is(err, db.ErrRetentionPolicyNotFound) {
+		return nil, adaptor.NewHttpError(http.StatusNotFound, err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &in, nil
+}
+
+// @Summary Apply the retention policies
+// @Description Remove the readings older than their retention policy now instead of waiting for the agent's
+// @Description retention job. With dryRun the report lists what would be removed without removing it.
+// @Tags retention
+// @Produce  json
+// @Param dryRun query bool false "Only report what would be removed"
+// @Success 200 {object} models.RetentionReport
+// @Router /retention_policies:apply [post]
+func (s *SensorSphere) HandleApplyRetention(ctx context.Context,
+	in models.ApplyRetentionRequest,
+) (*models.RetentionReport, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleApplyRetention")
+	defer span.End()
+
+	return s.database.ApplyRetention(ctx, in.DryRun)
+}
+
+// @Summary Get the compression settings
+// @Description Report whether the readings hypertable is compressed, how and when chunks get compressed.
+// @Tags compression
+// @Produce  json
+// @Success 200 {object} models.CompressionSettings
+// @Router /compression [get]
+func (s *SensorSphere) HandleGetCompressionSettings(ctx context.Context,
+	_ struct{},
+) (*models.CompressionSettings, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleGetCompressionSettings")
+	defer span.End()
+
+	return s.database.GetCompressionSettings(ctx)
+}
+
+// @Summary Configure compression
+// @Description Enable compression of the readings hypertable (segmented by sensor name, ordered by time) and
+// @Description compress chunks once they are older than compressAfter, or decompress every chunk and disable it.
+// @Tags compression
+// @Accept  json
+// @Produce  json
+// @Param settings body models.SetCompressionRequest true "Compression settings"
+// @Success 200 {object} models.CompressionSettings
+// @Router /compression [put]
+func (s *SensorSphere) HandleSetCompression(ctx context.Context,
+	in models.SetCompressionRequest,
+) (*models.CompressionSettings, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleSetCompression")
+	defer span.End()
+
+	if err := validateSetCompressionRequest(in); err != nil {
+		return nil, err
+	}
+
+	return s.database.SetCompression(ctx, in)
+}
+
+// @Summary List chunk compression
+// @Description List the chunks of the readings hypertable, oldest first, with the compression ratio of the
+// @Description compressed ones.
+// @Tags compression
+// @Produce  json
+// @Success 200 {array} models.ChunkCompression
+// @Router /compression/chunks [get]
+func (s *SensorSphere) HandleListChunkCompression(ctx context.Context,
+	_ struct{},
+) ([]*models.ChunkCompression, error) {
+	ctx, span := s.HttpTracer.Start(ctx, "HandleListChunkCompression")
+	defer span.End()
+
+	return s.database.ListChunkCompression(ctx)
+}
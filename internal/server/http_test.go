@@ -1,20 +1,27 @@
 package server_test
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	goparquet "github.com/fraugster/parquet-go"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
 
+	"github.com/koneal2013/sensorsphere/internal/db"
 	"github.com/koneal2013/sensorsphere/internal/models"
+	"github.com/koneal2013/sensorsphere/internal/pubsub"
 	"github.com/koneal2013/sensorsphere/internal/server"
 )
 
@@ -37,6 +44,13 @@ func (m *MockDb) GetSensor(ctx context.Context, name string) (*models.Sensor, er
 	return args.Get(0).(*models.Sensor), args.Error(1)
 }
 
+// ListSensors is a mock implementation of db.Db.ListSensors
+func (m *MockDb) ListSensors(ctx context.Context, query models.ListSensorsQuery) (*models.SensorPage, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).(*models.SensorPage), args.Error(1)
+}
+
 // UpdateSensor is a mock implementation of db.Db.UpdateSensor
 func (m *MockDb) UpdateSensor(ctx context.Context, sensor *models.Sensor) (int64, error) {
 	args := m.Called(ctx, sensor)
@@ -44,6 +58,13 @@ func (m *MockDb) UpdateSensor(ctx context.Context, sensor *models.Sensor) (int64
 	return args.Get(0).(int64), args.Error(1)
 }
 
+// DeleteSensor is a mock implementation of db.Db.DeleteSensor
+func (m *MockDb) DeleteSensor(ctx context.Context, name string, mode models.DeleteMode) (int64, error) {
+	args := m.Called(ctx, name, mode)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // GetNearestSensor is a mock implementation of db.Db.GetNearestSensor
 func (m *MockDb) GetNearestSensor(ctx context.Context, location *models.Location) (*models.Sensor, error) {
 	args := m.Called(ctx, location)
@@ -53,10 +74,10 @@ func (m *MockDb) GetNearestSensor(ctx context.Context, location *models.Location
 
 // GetSensorReadingsForTimeRange is a mock implementation of db.Db.GetSensorReadingsForTimeRange
 func (m *MockDb) GetSensorReadingsForTimeRange(ctx context.Context,
-	timeRange models.TimeRangeQuery) ([]*models.SensorReading, error) {
+	timeRange models.TimeRangeQuery) (*models.SensorReadingPage, error) {
 	args := m.Called(ctx, timeRange)
 
-	return args.Get(0).([]*models.SensorReading), args.Error(1)
+	return args.Get(0).(*models.SensorReadingPage), args.Error(1)
 }
 
 // CreateSensorReading is a mock implementation of db.Db.CreateSensorReading
@@ -67,6 +88,165 @@ func (m *MockDb) CreateSensorReading(ctx context.Context,
 	return args.Get(0).(*models.SensorReading), args.Error(1)
 }
 
+// CreateSensorReadings is a mock implementation of db.Db.CreateSensorReadings
+func (m *MockDb) CreateSensorReadings(ctx context.Context,
+	readings []*models.SensorReading, atomic bool) ([]error, error) {
+	args := m.Called(ctx, readings, atomic)
+
+	return args.Get(0).([]error), args.Error(1)
+}
+
+// CreateMissingSensors is a mock implementation of db.Db.CreateMissingSensors
+func (m *MockDb) CreateMissingSensors(ctx context.Context, names []string) ([]string, error) {
+	args := m.Called(ctx, names)
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// FindNearestSensors is a mock implementation of db.Db.FindNearestSensors
+func (m *MockDb) FindNearestSensors(ctx context.Context,
+	query models.NearestQuery) ([]*models.SensorDistance, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).([]*models.SensorDistance), args.Error(1)
+}
+
+// GetInterpolationSources is a mock implementation of db.Db.GetInterpolationSources
+func (m *MockDb) GetInterpolationSources(ctx context.Context,
+	query models.InterpolationQuery) ([]*models.InterpolationSource, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).([]*models.InterpolationSource), args.Error(1)
+}
+
+// FindSensorsInArea is a mock implementation of db.Db.FindSensorsInArea
+func (m *MockDb) FindSensorsInArea(ctx context.Context, query models.AreaQuery) (*models.SensorPage, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).(*models.SensorPage), args.Error(1)
+}
+
+// FindSensorsWithinRadius is a mock implementation of db.Db.FindSensorsWithinRadius
+func (m *MockDb) FindSensorsWithinRadius(ctx context.Context,
+	query models.RadiusQuery) (*models.SensorDistancePage, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).(*models.SensorDistancePage), args.Error(1)
+}
+
+// FlagSensorReadings is a mock implementation of db.Db.FlagSensorReadings
+func (m *MockDb) FlagSensorReadings(ctx context.Context, req models.FlagReadingsRequest) (int64, error) {
+	args := m.Called(ctx, req)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ExportSensorReadings is a mock implementation of db.Db.ExportSensorReadings. The readings returned by the
+// expectation are sent in batches of batchSize.
+func (m *MockDb) ExportSensorReadings(ctx context.Context, query models.ExportQuery, batchSize int,
+	send func([]*models.SensorReading) error) error {
+	args := m.Called(ctx, query, batchSize)
+
+	readings := args.Get(0).([]*models.SensorReading)
+	for len(readings) > 0 {
+		n := batchSize
+		if n <= 0 || n > len(readings) {
+			n = len(readings)
+		}
+
+		if err := send(readings[:n]); err != nil {
+			return err
+		}
+
+		readings = readings[n:]
+	}
+
+	return args.Error(1)
+}
+
+// GetSensorReadingSeries is a mock implementation of db.Db.GetSensorReadingSeries
+func (m *MockDb) GetSensorReadingSeries(ctx context.Context, query models.SeriesQuery,
+	maxSeries, maxReadings int) ([]*models.Series, error) {
+	args := m.Called(ctx, query, maxSeries, maxReadings)
+
+	return args.Get(0).([]*models.Series), args.Error(1)
+}
+
+// GetLatestReadings is a mock implementation of db.Db.GetLatestReadings
+func (m *MockDb) GetLatestReadings(ctx context.Context,
+	query models.LatestReadingsQuery) ([]*models.SensorReading, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).([]*models.SensorReading), args.Error(1)
+}
+
+// AggregateSensorReadings is a mock implementation of db.Db.AggregateSensorReadings
+func (m *MockDb) AggregateSensorReadings(ctx context.Context,
+	query models.AggregationQuery) ([]*models.AggregateBucket, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).([]*models.AggregateBucket), args.Error(1)
+}
+
+// ResampleSensorReadings is a mock implementation of db.Db.ResampleSensorReadings
+func (m *MockDb) ResampleSensorReadings(ctx context.Context,
+	query models.ResampleQuery) ([]*models.ResampledReading, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).([]*models.ResampledReading), args.Error(1)
+}
+
+// ListRetentionPolicies is a mock implementation of db.Db.ListRetentionPolicies
+func (m *MockDb) ListRetentionPolicies(ctx context.Context) ([]*models.RetentionPolicy, error) {
+	args := m.Called(ctx)
+
+	return args.Get(0).([]*models.RetentionPolicy), args.Error(1)
+}
+
+// SetRetentionPolicy is a mock implementation of db.Db.SetRetentionPolicy
+func (m *MockDb) SetRetentionPolicy(ctx context.Context,
+	policy *models.RetentionPolicy) (*models.RetentionPolicy, error) {
+	args := m.Called(ctx, policy)
+
+	return args.Get(0).(*models.RetentionPolicy), args.Error(1)
+}
+
+// DeleteRetentionPolicy is a mock implementation of db.Db.DeleteRetentionPolicy
+func (m *MockDb) DeleteRetentionPolicy(ctx context.Context, scope models.RetentionScope, target string) error {
+	args := m.Called(ctx, scope, target)
+
+	return args.Error(0)
+}
+
+// ApplyRetention is a mock implementation of db.Db.ApplyRetention
+func (m *MockDb) ApplyRetention(ctx context.Context, dryRun bool) (*models.RetentionReport, error) {
+	args := m.Called(ctx, dryRun)
+
+	return args.Get(0).(*models.RetentionReport), args.Error(1)
+}
+
+// GetCompressionSettings is a mock implementation of db.Db.GetCompressionSettings
+func (m *MockDb) GetCompressionSettings(ctx context.Context) (*models.CompressionSettings, error) {
+	args := m.Called(ctx)
+
+	return args.Get(0).(*models.CompressionSettings), args.Error(1)
+}
+
+// SetCompression is a mock implementation of db.Db.SetCompression
+func (m *MockDb) SetCompression(ctx context.Context,
+	req models.SetCompressionRequest) (*models.CompressionSettings, error) {
+	args := m.Called(ctx, req)
+
+	return args.Get(0).(*models.CompressionSettings), args.Error(1)
+}
+
+// ListChunkCompression is a mock implementation of db.Db.ListChunkCompression
+func (m *MockDb) ListChunkCompression(ctx context.Context) ([]*models.ChunkCompression, error) {
+	args := m.Called(ctx)
+
+	return args.Get(0).([]*models.ChunkCompression), args.Error(1)
+}
+
 // Close is a mock implementation of db.Db.Close
 func (m *MockDb) Close() error {
 	args := m.Called()
@@ -233,6 +413,268 @@ func TestHandleGetNearestSensor(t *testing.T) {
 	mockDB.AssertExpectations(t)
 }
 
+func TestHandleFindNearestSensors(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	query := models.NearestQuery{
+		Longitude:      -0.1276,
+		Latitude:       51.5072,
+		K:              2,
+		MaxDistance:    10000,
+		AnyTags:        []string{"air", "noise"},
+		ReportedWithin: models.Duration(15 * time.Minute),
+	}
+	mockDB.On("FindNearestSensors", mock.Anything, query).Return([]*models.SensorDistance{
+		{
+			Sensor:   models.Sensor{Name: "Sensor A", Location: models.Location{Longitude: -0.13, Latitude: 51.51}},
+			Distance: 350.5,
+		},
+		{
+			Sensor:   models.Sensor{Name: "Sensor B", Location: models.Location{Longitude: -0.1, Latitude: 51.5}},
+			Distance: 2048,
+		},
+	}, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet, "/sensors:nearest?longitude=-0.1276&latitude=51.5072&k=2"+
+		"&maxDistance=10000&anyTags=air&anyTags=noise&reportedWithin=15m", io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	var res models.NearestSensorsResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	require.Len(t, res.Sensors, 2)
+	require.Equal(t, "Sensor A", res.Sensors[0].Name)
+	require.Equal(t, 350.5, res.Sensors[0].Distance)
+	require.Equal(t, "Sensor B", res.Sensors[1].Name)
+	require.Equal(t, 2048.0, res.Sensors[1].Distance)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFindSensorsWithinRadius(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	query := models.RadiusQuery{Longitude: -0.1276, Latitude: 51.5072, Radius: 5000, AllTags: []string{"air"}}
+	mockDB.On("FindSensorsWithinRadius", mock.Anything, query).Return(&models.SensorDistancePage{
+		Sensors: []*models.SensorDistance{
+			{
+				Sensor: models.Sensor{
+					Name:     "Sensor A",
+					Location: models.Location{Longitude: -0.13, Latitude: 51.51},
+					Tags:     []string{"air"},
+				},
+				Distance: 350.5,
+			},
+		},
+		NextPageToken: "next",
+	}, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensors/within?longitude=-0.1276&latitude=51.5072&radius=5000&allTags=air", io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"sensors":[{"name":"Sensor A","location":{"longitude":-0.13,"latitude":51.51},"tags":["air"],` +
+		`"distance":350.5}],"nextPageToken":"next"}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFindSensorsWithinRadiusInvalidRadius(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request with a negative radius
+	req, _ := http.NewRequest(http.MethodGet, "/sensors/within?longitude=-0.1276&latitude=51.5072&radius=-5",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The query must never reach the database
+	mockDB.AssertNotCalled(t, "FindSensorsWithinRadius", mock.Anything, mock.Anything)
+}
+
+func TestHandleFindSensorsInAreaPolygon(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations: the feature's polygon is handed to the database
+	mockDB.On("FindSensorsInArea", mock.Anything, mock.MatchedBy(func(q models.AreaQuery) bool {
+		polygon, ok := q.Area.(*geom.Polygon)
+		return ok && polygon.NumLinearRings() == 1 && polygon.NumCoords() == 5 && q.BoundingBox == nil &&
+			len(q.AllTags) == 1 && q.AllTags[0] == "air"
+	})).Return(&models.SensorPage{Sensors: []*models.Sensor{{Name: "Sensor A", Tags: []string{"air"}}}}, nil)
+
+	// Create a new HTTP request with a polygon drawn on a map
+	body := `{"geometry":{"type":"Feature","properties":{},"geometry":{"type":"Polygon",` +
+		`"coordinates":[[[-0.2,51.4],[0.0,51.4],[0.0,51.6],[-0.2,51.6],[-0.2,51.4]]]}},"allTags":["air"]}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensors/search/area", strings.NewReader(body))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"sensors":[{"name":"Sensor A","location":{"longitude":0,"latitude":0},"tags":["air"]}]}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFindSensorsInAreaBoundingBox(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	box := &models.BoundingBox{MinLongitude: -0.2, MinLatitude: 51.4, MaxLongitude: 0.0, MaxLatitude: 51.6}
+	mockDB.On("FindSensorsInArea", mock.Anything, models.AreaQuery{BoundingBox: box, PageSize: 10}).
+		Return(&models.SensorPage{Sensors: []*models.Sensor{}}, nil)
+
+	// Create a new HTTP request
+	body := `{"bbox":{"minLongitude":-0.2,"minLatitude":51.4,"maxLongitude":0.0,"maxLatitude":51.6},"pageSize":10}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensors/search/area", strings.NewReader(body))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFindSensorsInAreaAntimeridian(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations: a box whose minimum longitude is east of its maximum crosses the antimeridian
+	box := &models.BoundingBox{MinLongitude: 170, MinLatitude: -20, MaxLongitude: -170, MaxLatitude: -10}
+	mockDB.On("FindSensorsInArea", mock.Anything, models.AreaQuery{BoundingBox: box}).
+		Return(&models.SensorPage{Sensors: []*models.Sensor{}}, nil)
+
+	// Create a new HTTP request
+	body := `{"bbox":{"minLongitude":170,"minLatitude":-20,"maxLongitude":-170,"maxLatitude":-10}}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensors/search/area", strings.NewReader(body))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFindSensorsInAreaInvalidGeometry(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "point", body: `{"geometry":{"type":"Point","coordinates":[-0.1,51.5]}}`},
+		{name: "open ring", body: `{"geometry":{"type":"Polygon","coordinates":[[[-0.2,51.4],[0.0,51.4],[0.0,51.6]]]}}`},
+		{name: "inverted bbox", body: `{"bbox":{"minLongitude":0,"minLatitude":51.6,"maxLongitude":1,"maxLatitude":51.4}}`},
+		{name: "no area", body: `{"anyTags":["air"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create a new instance of our mock Db
+			mockDB := new(MockDb)
+
+			// Create a new HTTP server with the mock database
+			svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+			require.NoError(t, err)
+
+			// Create a new HTTP request
+			req, _ := http.NewRequest(http.MethodPost, "/sensors/search/area", strings.NewReader(tt.body))
+
+			// Create a ResponseRecorder to record the response
+			rr := httptest.NewRecorder()
+
+			// Serve the request using the router
+			svr.Handler.ServeHTTP(rr, req)
+
+			// Check the status code
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+
+			// The query must never reach the database
+			mockDB.AssertNotCalled(t, "FindSensorsInArea", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestHandleCreateSensorReading(t *testing.T) {
 	// Create a new instance of our mock Db
 	mockDB := new(MockDb)
@@ -279,21 +721,28 @@ func TestHandleGetSensorReadingsForTimeRange(t *testing.T) {
 	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
 	require.NoError(t, err)
 
-	// Create a new time range query
+	// Create a new time range query asking for the newest readings first
+	now := time.Now().UTC().Truncate(time.Second)
 	timeRangeQuery := models.TimeRangeQuery{
 		SensorName: "Test Sensor",
-		StartTime:  time.Now().Add(-1 * time.Hour).Truncate(time.Second),
-		EndTime:    time.Now().Truncate(time.Second),
+		StartTime:  now.Add(-1 * time.Hour),
+		EndTime:    now,
+		PageSize:   2,
+		PageToken:  "token",
+		Order:      models.SortOrderDesc,
 	}
 
-	// Create a slice of sensor readings
-	sensorReadings := []*models.SensorReading{
-		{SensorName: "Test Sensor", Value: 1.0, Time: time.Now().Add(-30 * time.Minute)},
-		{SensorName: "Test Sensor", Value: 2.0, Time: time.Now().Add(-15 * time.Minute)},
+	// Create a page of sensor readings
+	page := &models.SensorReadingPage{
+		SensorReadings: []*models.SensorReading{
+			{SensorName: "Test Sensor", Value: 2.0, Time: now.Add(-15 * time.Minute)},
+			{SensorName: "Test Sensor", Value: 1.0, Time: now.Add(-30 * time.Minute)},
+		},
+		NextPageToken: "next",
 	}
 
 	// Setup expectations
-	mockDB.On("GetSensorReadingsForTimeRange", mock.Anything, timeRangeQuery).Return(sensorReadings, nil)
+	mockDB.On("GetSensorReadingsForTimeRange", mock.Anything, timeRangeQuery).Return(page, nil)
 
 	// Convert the time range query to JSON
 	jsonTimeRangeQuery, _ := json.Marshal(timeRangeQuery)
@@ -312,9 +761,10 @@ func TestHandleGetSensorReadingsForTimeRange(t *testing.T) {
 
 	// Check the response body
 	expected := fmt.Sprintf(
-		"[{\"sensorName\":\"Test Sensor\",\"time\":\"%s\",\"value\":1},{\"sensorName\":\"Test Sensor\",\"time\":\"%s\",\"value\":2}]\n",
-		sensorReadings[0].Time.Format(time.RFC3339Nano),
-		sensorReadings[1].Time.Format(time.RFC3339Nano),
+		"{\"sensorReadings\":[{\"sensorName\":\"Test Sensor\",\"time\":\"%s\",\"value\":2},"+
+			"{\"sensorName\":\"Test Sensor\",\"time\":\"%s\",\"value\":1}],\"nextPageToken\":\"next\"}\n",
+		page.SensorReadings[0].Time.Format(time.RFC3339Nano),
+		page.SensorReadings[1].Time.Format(time.RFC3339Nano),
 	)
 
 	require.Equal(t, expected, rr.Body.String())
@@ -322,3 +772,1800 @@ func TestHandleGetSensorReadingsForTimeRange(t *testing.T) {
 	// Assert that the expectations were met
 	mockDB.AssertExpectations(t)
 }
+
+func TestHandleGetSensorReadingsForTimeRangeInvalidOrder(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request with an unknown sort order
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings?sensorName=Test+Sensor&startTime=2023-08-01T00:00:00Z&endTime=2023-08-02T00:00:00Z"+
+			"&order=sideways", io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The database is never queried
+	mockDB.AssertNotCalled(t, "GetSensorReadingsForTimeRange", mock.Anything, mock.Anything)
+}
+func TestHandleDeleteSensor(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	mockDB.On("DeleteSensor", mock.Anything, "Test Sensor", models.DeleteModeCascade).Return(int64(3), nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodDelete, "/sensors/Test Sensor?mode=cascade", io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"name":"Test Sensor","mode":"cascade","readingsDeleted":3}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleDeleteSensorNotFound(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	mockDB.On("DeleteSensor", mock.Anything, "Test Sensor", models.DeleteModeRestrict).
+		Return(int64(0), db.ErrSensorNotFound)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodDelete, "/sensors/Test Sensor", io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleListSensors(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the query the URL parameters should decode into
+	query := models.ListSensorsQuery{
+		PageSize:   2,
+		NamePrefix: "Test",
+		AnyTags:    []string{"indoor", "outdoor"},
+		AllTags:    []string{"temperature"},
+		Order:      models.SortOrderDesc,
+	}
+
+	// Create a page of sensors
+	page := &models.SensorPage{
+		Sensors:       []*models.Sensor{{Name: "Test Sensor 2"}, {Name: "Test Sensor 1"}},
+		NextPageToken: "next",
+	}
+
+	// Setup expectations
+	mockDB.On("ListSensors", mock.Anything, query).Return(page, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensors?pageSize=2&namePrefix=Test&anyTags=indoor&anyTags=outdoor&allTags=temperature&order=desc",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"sensors":[{"name":"Test Sensor 2","location":{"longitude":0,"latitude":0},"tags":null},` +
+		`{"name":"Test Sensor 1","location":{"longitude":0,"latitude":0},"tags":null}],"nextPageToken":"next"}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleListSensorsGeoJSON(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a page of sensors, one of them created by an import without a location
+	page := &models.SensorPage{
+		Sensors: []*models.Sensor{
+			{Name: "Sensor A", Location: models.Location{Longitude: -0.1, Latitude: 51.5}, Tags: []string{"air"},
+				Unit: "degC"},
+			{Name: "Sensor B"},
+		},
+		NextPageToken: "next",
+	}
+	readingTime := time.Date(2023, 8, 24, 10, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	mockDB.On("ListSensors", mock.Anything, models.ListSensorsQuery{}).Return(page, nil)
+	mockDB.On("GetLatestReadings", mock.Anything,
+		models.LatestReadingsQuery{SensorNames: []string{"Sensor A", "Sensor B"}}).
+		Return([]*models.SensorReading{
+			{SensorName: "Sensor A", Time: readingTime, Value: 21.5, Quality: models.QualityGood},
+		}, nil)
+
+	// Create a new HTTP request asking for GeoJSON
+	req, _ := http.NewRequest(http.MethodGet, "/sensors", io.NopCloser(bytes.NewReader(nil)))
+	req.Header.Set("Accept", "application/geo+json, application/json;q=0.9")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code and content type
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/geo+json", rr.Header().Get("Content-Type"))
+
+	// Check the response body
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","id":"Sensor A","geometry":{"type":"Point","coordinates":[-0.1,51.5]},` +
+		`"properties":{"latestQuality":"good","latestTime":"2023-08-24T10:00:00Z","latestValue":21.5,` +
+		`"name":"Sensor A","tags":["air"],"unit":"degC"}},` +
+		`{"type":"Feature","id":"Sensor B","geometry":null,"properties":{"name":"Sensor B","tags":[]}}],` +
+		`"nextPageToken":"next"}`
+	require.JSONEq(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFindSensorsWithinRadiusGeoJSON(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	query := models.RadiusQuery{Longitude: -0.1, Latitude: 51.5, Radius: 1000}
+	mockDB.On("FindSensorsWithinRadius", mock.Anything, query).Return(&models.SensorDistancePage{
+		Sensors: []*models.SensorDistance{{
+			Sensor:   models.Sensor{Name: "Sensor A", Location: models.Location{Longitude: -0.1, Latitude: 51.501}},
+			Distance: 111.2,
+		}},
+	}, nil)
+	mockDB.On("GetLatestReadings", mock.Anything, models.LatestReadingsQuery{SensorNames: []string{"Sensor A"}}).
+		Return([]*models.SensorReading{}, nil)
+
+	// Create a new HTTP request asking for GeoJSON
+	req, _ := http.NewRequest(http.MethodGet, "/sensors/within?longitude=-0.1&latitude=51.5&radius=1000",
+		io.NopCloser(bytes.NewReader(nil)))
+	req.Header.Set("Accept", "application/geo+json")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","id":"Sensor A","geometry":{"type":"Point","coordinates":[-0.1,51.501]},` +
+		`"properties":{"distance":111.2,"name":"Sensor A","tags":[]}}]}`
+	require.JSONEq(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleGetNearestSensorGeoJSON(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	location := models.Location{Longitude: -0.1, Latitude: 51.5}
+	mockDB.On("GetNearestSensor", mock.Anything, &location).Return(&models.Sensor{
+		Name:     "Sensor A",
+		Location: models.Location{Longitude: -0.1, Latitude: 51.501},
+	}, nil)
+	mockDB.On("GetLatestReadings", mock.Anything, models.LatestReadingsQuery{SensorNames: []string{"Sensor A"}}).
+		Return([]*models.SensorReading{}, nil)
+
+	// Create a new HTTP request asking for GeoJSON
+	req, _ := http.NewRequest(http.MethodGet, "/sensors/nearest?longitude=-0.1&latitude=51.5",
+		io.NopCloser(bytes.NewReader(nil)))
+	req.Header.Set("Accept", "application/geo+json")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","id":"Sensor A","geometry":{"type":"Point","coordinates":[-0.1,51.501]},` +
+		`"properties":{"name":"Sensor A","tags":[]}}]}`
+	require.JSONEq(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleCreateSensorGeoJSON(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations: the feature is created as the sensor it describes
+	sensor := &models.Sensor{
+		Name:        "Sensor A",
+		Location:    models.Location{Longitude: -0.1, Latitude: 51.5},
+		Tags:        []string{"air"},
+		Unit:        "degC",
+		RangePolicy: models.RangePolicyReject,
+	}
+	mockDB.On("CreateSensor", mock.Anything, sensor).Return(sensor, nil)
+
+	// Create a new HTTP request with a GeoJSON Feature named by its id
+	body := `{"type":"Feature","id":"Sensor A","geometry":{"type":"Point","coordinates":[-0.1,51.5]},` +
+		`"properties":{"tags":["air"],"unit":"degC"}}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensors", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/geo+json")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleCreateSensorGeoJSONNotAPoint(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request with a feature that is not a point
+	body := `{"type":"Feature","geometry":{"type":"LineString","coordinates":[[-0.1,51.5],[-0.2,51.6]]},` +
+		`"properties":{"name":"Sensor A","tags":["air"]}}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensors", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/geo+json")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The sensor must never reach the database
+	mockDB.AssertNotCalled(t, "CreateSensor", mock.Anything, mock.Anything)
+}
+
+func TestHandleCreateSensorDeleted(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations: the name belongs to a soft-deleted sensor
+	mockDB.On("CreateSensor", mock.Anything, mock.Anything).Return((*models.Sensor)(nil), db.ErrSensorDeleted)
+
+	// Create a new HTTP request
+	body := `{"name":"Sensor A","location":{"longitude":-0.1,"latitude":51.5},"tags":["air"]}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensors", strings.NewReader(body))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code and that the reason is given rather than the driver's error
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Contains(t, rr.Body.String(), "sensor has been deleted")
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleInterpolateSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	at := time.Date(2023, 8, 25, 12, 0, 0, 0, time.UTC)
+
+	// Setup expectations: the defaults are filled in and two sensors are found, one twice as far as the other
+	query := models.InterpolationQuery{
+		Longitude:       -0.1,
+		Latitude:        51.5,
+		Time:            at,
+		Method:          models.InterpolationIDW,
+		K:               8,
+		Window:          models.Duration(15 * time.Minute),
+		MeasurementType: "temperature",
+		Power:           2,
+	}
+	mockDB.On("GetInterpolationSources", mock.Anything, query).Return([]*models.InterpolationSource{
+		{SensorName: "Sensor A", Location: models.Location{Longitude: -0.1, Latitude: 51.501}, Distance: 111.3,
+			Time: at.Add(-time.Minute), Value: 10, Unit: "degC"},
+		{SensorName: "Sensor B", Location: models.Location{Longitude: -0.1, Latitude: 51.498}, Distance: 222.6,
+			Time: at.Add(2 * time.Minute), Value: 20, Unit: "degC"},
+	}, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings:interpolate?longitude=-0.1&latitude=51.5&time=2023-08-25T12:00:00Z&measurementType=temperature",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response: the nearer sensor weighs four times as much
+	var res models.Interpolation
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	require.Equal(t, models.InterpolationIDW, res.Method)
+	require.Equal(t, "degC", res.Unit)
+	require.InDelta(t, 12, res.Value, 0.01)
+	require.Nil(t, res.Variance)
+	require.Len(t, res.Sensors, 2)
+	require.InDelta(t, 0.8, res.Sensors[0].Weight, 0.001)
+	require.InDelta(t, 0.2, res.Sensors[1].Weight, 0.001)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleInterpolateSensorReadingsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		sources []*models.InterpolationSource
+		code    int
+	}{
+		{
+			name: "unknown method",
+			url:  "/sensor_readings:interpolate?longitude=-0.1&latitude=51.5&method=spline",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "too many sensors",
+			url:  "/sensor_readings:interpolate?longitude=-0.1&latitude=51.5&k=1000",
+			code: http.StatusBadRequest,
+		},
+		{
+			name:    "no readings",
+			url:     "/sensor_readings:interpolate?longitude=-0.1&latitude=51.5",
+			sources: []*models.InterpolationSource{},
+			code:    http.StatusNotFound,
+		},
+		{
+			name: "mixed units",
+			url:  "/sensor_readings:interpolate?longitude=-0.1&latitude=51.5",
+			sources: []*models.InterpolationSource{
+				{SensorName: "Sensor A", Location: models.Location{Longitude: -0.1, Latitude: 51.501}, Unit: "degC"},
+				{SensorName: "Sensor B", Location: models.Location{Longitude: -0.1, Latitude: 51.499}, Unit: "degF"},
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create a new instance of our mock Db
+			mockDB := new(MockDb)
+
+			// Create a new HTTP server with the mock database
+			svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+			require.NoError(t, err)
+
+			// Setup expectations
+			if tt.sources != nil {
+				mockDB.On("GetInterpolationSources", mock.Anything, mock.Anything).Return(tt.sources, nil)
+			}
+
+			// Create a new HTTP request
+			req, _ := http.NewRequest(http.MethodGet, tt.url, io.NopCloser(bytes.NewReader(nil)))
+
+			// Create a ResponseRecorder to record the response
+			rr := httptest.NewRecorder()
+
+			// Serve the request using the router
+			svr.Handler.ServeHTTP(rr, req)
+
+			// Check the status code
+			require.Equal(t, tt.code, rr.Code)
+
+			// Assert that the expectations were met
+			mockDB.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleCreateSensorReadingWithClientTime(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server that accepts readings up to a day old
+	svr, err := server.NewHTTPServer(&server.HttpConfig{
+		Port:              8080,
+		Db:                mockDB,
+		ReadingTimeWindow: server.ReadingTimeWindow{MaxFuture: time.Minute, MaxPast: 24 * time.Hour},
+	})
+	require.NoError(t, err)
+
+	// Create a buffered sensor reading taken an hour ago
+	reading := models.SensorReading{
+		SensorName: "Test Sensor",
+		Value:      1.0,
+		Time:       time.Now().Add(-1 * time.Hour).UTC().Truncate(time.Second),
+	}
+
+	// Setup expectations
+	mockDB.On("CreateSensorReading", mock.Anything, &reading).Return(&reading, nil)
+
+	// Convert the reading to JSON
+	jsonReading, _ := json.Marshal(reading)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings", bytes.NewBuffer(jsonReading))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := fmt.Sprintf("{\"sensorName\":\"Test Sensor\",\"time\":\"%s\",\"value\":1}\n",
+		reading.Time.Format(time.RFC3339Nano))
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleCreateSensorReadingOutsideTimeWindow(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server that rejects readings more than a minute in the future
+	svr, err := server.NewHTTPServer(&server.HttpConfig{
+		Port:              8080,
+		Db:                mockDB,
+		ReadingTimeWindow: server.ReadingTimeWindow{MaxFuture: time.Minute},
+	})
+	require.NoError(t, err)
+
+	// Create a sensor reading from a device whose clock runs an hour ahead
+	reading := models.SensorReading{SensorName: "Test Sensor", Value: 1.0, Time: time.Now().Add(time.Hour)}
+
+	// Convert the reading to JSON
+	jsonReading, _ := json.Marshal(reading)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings", bytes.NewBuffer(jsonReading))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+
+	// The reading must never reach the database
+	mockDB.AssertNotCalled(t, "CreateSensorReading", mock.Anything, mock.Anything)
+}
+
+func TestHandleCreateSensorReadingOutOfRange(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// The sensor's range rejects the reading
+	reading := models.SensorReading{SensorName: "Test Sensor", Value: 150.0}
+	mockDB.On("CreateSensorReading", mock.Anything, &reading).
+		Return((*models.SensorReading)(nil), db.ErrValueOutOfRange)
+
+	// Convert the reading to JSON
+	jsonReading, _ := json.Marshal(reading)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings", bytes.NewBuffer(jsonReading))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	require.Contains(t, rr.Body.String(), db.ErrValueOutOfRange.Error())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleCreateSensorInvalidRange(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a sensor whose minimum lies above its maximum
+	minValue, maxValue := 100.0, -40.0
+	sensor := models.Sensor{
+		Name:     "Test Sensor",
+		Location: models.Location{Longitude: 1.0, Latitude: 1.0},
+		Tags:     []string{},
+		Unit:     "degC",
+		MinValue: &minValue,
+		MaxValue: &maxValue,
+	}
+
+	// Convert the sensor to JSON
+	jsonSensor, _ := json.Marshal(sensor)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensors", bytes.NewBuffer(jsonSensor))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The sensor must never reach the database
+	mockDB.AssertNotCalled(t, "CreateSensor", mock.Anything, mock.Anything)
+}
+
+func TestHandleCreateSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a batch where the second reading is incomplete and the third belongs to an unknown sensor
+	readings := []*models.SensorReading{
+		{SensorName: "Test Sensor", Value: 1.0},
+		{SensorName: "Test Sensor"},
+		{SensorName: "Unknown Sensor", Value: 3.0},
+	}
+
+	// Setup expectations: only the complete readings reach the database
+	mockDB.On("CreateSensorReadings", mock.Anything, []*models.SensorReading{readings[0], readings[2]}, false).
+		Return([]error{nil, db.ErrSensorNotFound}, nil)
+
+	// Convert the batch to JSON
+	jsonBatch, _ := json.Marshal(models.BatchSensorReadingsRequest{Readings: readings})
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:batch", bytes.NewBuffer(jsonBatch))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"accepted":1,"rejected":2,"results":[` +
+		`{"index":0,"reading":{"sensorName":"Test Sensor","time":"0001-01-01T00:00:00Z","value":1}},` +
+		`{"index":1,"error":"missing required fields"},` +
+		`{"index":2,"error":"sensor not found"}]}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleCreateSensorReadingsAtomic(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create an all-or-nothing batch where the second reading is incomplete
+	batch := models.BatchSensorReadingsRequest{
+		Readings: []*models.SensorReading{{SensorName: "Test Sensor", Value: 1.0}, {SensorName: "Test Sensor"}},
+		Atomic:   true,
+	}
+
+	// Convert the batch to JSON
+	jsonBatch, _ := json.Marshal(batch)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:batch", bytes.NewBuffer(jsonBatch))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"accepted":0,"rejected":2,"results":[` +
+		`{"index":0,"error":"not written because another reading in the batch was rejected"},` +
+		`{"index":1,"error":"missing required fields"}]}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Nothing may be written when the batch is aborted
+	mockDB.AssertNotCalled(t, "CreateSensorReadings", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleFlagSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Flag an afternoon of readings as suspect
+	start := time.Date(2023, 8, 21, 12, 0, 0, 0, time.UTC)
+	flag := models.FlagReadingsRequest{
+		SensorName: "Test Sensor",
+		StartTime:  start,
+		EndTime:    start.Add(4 * time.Hour),
+		Quality:    models.QualitySuspect,
+		Annotation: "sensor housing in direct sunlight",
+	}
+
+	// Setup expectations
+	mockDB.On("FlagSensorReadings", mock.Anything, flag).Return(int64(240), nil)
+
+	// Convert the request to JSON
+	jsonFlag, _ := json.Marshal(flag)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:flag", bytes.NewBuffer(jsonFlag))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	require.Equal(t, "{\"updated\":240}\n", rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleFlagSensorReadingsInvalidQuality(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request with an unknown quality code
+	body := `{"sensorName":"Test Sensor","startTime":"2023-08-21T12:00:00Z","endTime":"2023-08-21T16:00:00Z",` +
+		`"quality":"dubious"}`
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:flag", strings.NewReader(body))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), `invalid quality "dubious"`)
+
+	// The request must never reach the database
+	mockDB.AssertNotCalled(t, "FlagSensorReadings", mock.Anything, mock.Anything)
+}
+
+func TestHandleStreamSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with a hub to publish readings to
+	hub := pubsub.NewHub(mockDB)
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB, Hub: hub})
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(svr.Handler)
+	defer ts.Close()
+
+	since := time.Now().Add(-1 * time.Hour).UTC().Truncate(time.Second)
+	stored := &models.SensorReading{SensorName: "Test Sensor", Value: 1.0, Time: since.Add(time.Minute)}
+
+	// Setup expectations: the reading stored since the requested time is replayed first
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.MatchedBy(func(q models.ExportQuery) bool {
+		return len(q.SensorNames) == 1 && q.SensorNames[0] == "Test Sensor" && q.StartTime.Equal(since)
+	}), mock.Anything).Return([]*models.SensorReading{stored}, nil)
+
+	// Open the event stream
+	res, err := http.Get(ts.URL + "/sensor_readings/stream?sensorName=Test+Sensor&since=" + since.Format(time.RFC3339))
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	// Check the status code and content type
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	events := bufio.NewReader(res.Body)
+	readEvent := func() string {
+		var event strings.Builder
+		for {
+			line, err := events.ReadString('\n')
+			require.NoError(t, err)
+			if line == "\n" {
+				return event.String()
+			}
+			event.WriteString(line)
+		}
+	}
+
+	// Check the replayed event, whose id is the reading's time and escaped sensor name
+	event := "id: %[1]s|Test%%20Sensor\nevent: reading\n" +
+		"data: {\"sensorName\":\"Test Sensor\",\"time\":\"%[2]s\",\"value\":%[3]v}\n"
+	expected := fmt.Sprintf(event, stored.Time.Format(time.RFC3339Nano), stored.Time.Format(time.RFC3339Nano),
+		stored.Value)
+	require.Equal(t, expected, readEvent())
+
+	// The server subscribed before replaying, so readings published now are streamed live
+	live := &models.SensorReading{SensorName: "Test Sensor", Value: 2.0, Time: since.Add(time.Hour)}
+	hub.Publish(context.Background(), &models.SensorReading{SensorName: "Other Sensor", Value: 3.0}, live)
+
+	expected = fmt.Sprintf(event, live.Time.Format(time.RFC3339Nano), live.Time.Format(time.RFC3339Nano), live.Value)
+	require.Equal(t, expected, readEvent())
+
+	// A reading taken before the latest one sent keeps the id of the latest, so resuming does not send it again
+	late := &models.SensorReading{SensorName: "Test Sensor", Value: 4.0, Time: since.Add(30 * time.Minute)}
+	hub.Publish(context.Background(), late)
+
+	expected = fmt.Sprintf(event, live.Time.Format(time.RFC3339Nano), late.Time.Format(time.RFC3339Nano), late.Value)
+	require.Equal(t, expected, readEvent())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleStreamSensorReadingsLastEventID(t *testing.T) {
+	// Create a new HTTP server with a hub to publish readings to
+	mockDB := new(MockDb)
+	hub := pubsub.NewHub(mockDB)
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB, Hub: hub})
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(svr.Handler)
+	defer ts.Close()
+
+	lastEvent := time.Now().Add(-1 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	// Setup expectations: the replay resumes after the reading the last event was for rather than at it, so
+	// the readings of other sensors taken at the same time still follow
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.MatchedBy(func(q models.ExportQuery) bool {
+		return q.StartTime.Equal(lastEvent) && q.AfterSensorName == "Test Sensor"
+	}), mock.Anything).Return([]*models.SensorReading{}, nil)
+
+	// Reconnect the way a browser does, sending the id of the last event alongside the original since
+	req, err := http.NewRequest(http.MethodGet,
+		ts.URL+"/sensor_readings/stream?sensorName=Test+Sensor&since=2023-08-01T00:00:00Z", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", lastEvent.Format(time.RFC3339Nano)+"|Test%20Sensor")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	// The response starts once the replay is done, so closing the hub now just ends the stream
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	hub.Close()
+
+	_, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleStreamSensorReadingsHubClosed(t *testing.T) {
+	// Create a new HTTP server with a hub to publish readings to
+	mockDB := new(MockDb)
+	hub := pubsub.NewHub(mockDB)
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB, Hub: hub})
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(svr.Handler)
+	defer ts.Close()
+
+	// Open the event stream
+	res, err := http.Get(ts.URL + "/sensor_readings/stream?sensorName=Test+Sensor")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// Closing the hub, as the agent does on shutdown, ends the idle stream so the server can shut down
+	hub.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, "event: error\ndata: {\"error\":\"server is shutting down\"}\n\n", string(body))
+}
+
+func TestHandleAggregateSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the query the URL parameters should decode into
+	startTime := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	query := models.AggregationQuery{
+		SensorName:  "Test Sensor",
+		StartTime:   startTime,
+		EndTime:     startTime.Add(2 * time.Hour),
+		BucketWidth: models.Duration(time.Hour),
+		Functions:   []models.AggregateFunction{models.AggregateAvg, models.AggregateMax},
+	}
+
+	// Create the buckets
+	buckets := []*models.AggregateBucket{
+		{Time: startTime, Values: map[models.AggregateFunction]float64{"avg": 20.5, "max": 22}},
+		{Time: startTime.Add(time.Hour), Values: map[models.AggregateFunction]float64{"avg": 21, "max": 21}},
+	}
+
+	// Setup expectations
+	mockDB.On("AggregateSensorReadings", mock.Anything, query).Return(buckets, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/aggregate?sensorName=Test+Sensor&startTime=2023-08-01T00:00:00Z"+
+			"&endTime=2023-08-01T02:00:00Z&bucketWidth=1h&functions=avg&functions=max",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `[{"time":"2023-08-01T00:00:00Z","values":{"avg":20.5,"max":22}},` +
+		`{"time":"2023-08-01T01:00:00Z","values":{"avg":21,"max":21}}]
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleAggregateSensorReadingsTooManyBuckets(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// A year in one-second buckets is far more than the server will return
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/aggregate?sensorName=Test+Sensor&startTime=2022-08-01T00:00:00Z"+
+			"&endTime=2023-08-01T00:00:00Z&bucketWidth=1s",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The database must not be queried
+	mockDB.AssertNotCalled(t, "AggregateSensorReadings", mock.Anything, mock.Anything)
+}
+
+func TestHandleSetRetentionPolicy(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new retention policy
+	policy := models.RetentionPolicy{
+		Scope:  models.RetentionScopeTag,
+		Target: "indoor",
+		MaxAge: models.Duration(30 * 24 * time.Hour),
+	}
+
+	// Setup expectations
+	mockDB.On("SetRetentionPolicy", mock.Anything, &policy).Return(&policy, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPut, "/retention_policies",
+		bytes.NewBufferString(`{"scope":"tag","target":"indoor","maxAge":"720h"}`))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"scope":"tag","target":"indoor","maxAge":"720h0m0s"}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleSetRetentionPolicyWithoutTarget(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request for a sensor policy that does not name the sensor
+	req, _ := http.NewRequest(http.MethodPut, "/retention_policies",
+		bytes.NewBufferString(`{"scope":"sensor","maxAge":"24h"}`))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The database must not be touched
+	mockDB.AssertNotCalled(t, "SetRetentionPolicy", mock.Anything, mock.Anything)
+}
+
+func TestHandleApplyRetentionDryRun(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the report of a dry run
+	cutoff := time.Date(2023, 7, 11, 0, 0, 0, 0, time.UTC)
+	report := &models.RetentionReport{
+		DryRun:        true,
+		DroppedChunks: []string{"_timescaledb_internal._hyper_1_1_chunk"},
+		Sensors: []models.SensorRetention{
+			{SensorName: "Test Sensor", MaxAge: models.Duration(720 * time.Hour), Cutoff: cutoff, ReadingsDeleted: 42},
+		},
+		ReadingsDeleted: 42,
+	}
+
+	// Setup expectations
+	mockDB.On("ApplyRetention", mock.Anything, true).Return(report, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/retention_policies:apply?dryRun=true",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"dryRun":true,"droppedChunks":["_timescaledb_internal._hyper_1_1_chunk"],` +
+		`"sensors":[{"sensorName":"Test Sensor","maxAge":"720h0m0s","cutoff":"2023-07-11T00:00:00Z",` +
+		`"readingsDeleted":42}],"readingsDeleted":42}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleSetCompression(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the expected settings
+	req := models.SetCompressionRequest{Enabled: true, CompressAfter: models.Duration(72 * time.Hour)}
+	settings := &models.CompressionSettings{
+		Enabled:       true,
+		SegmentBy:     []string{"name"},
+		OrderBy:       []string{"time DESC"},
+		CompressAfter: req.CompressAfter,
+	}
+
+	// Setup expectations
+	mockDB.On("SetCompression", mock.Anything, req).Return(settings, nil)
+
+	// Create a new HTTP request
+	httpReq, _ := http.NewRequest(http.MethodPut, "/compression",
+		bytes.NewBufferString(`{"enabled":true,"compressAfter":"72h"}`))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, httpReq)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"enabled":true,"segmentBy":["name"],"orderBy":["time DESC"],"compressAfter":"72h0m0s"}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleListChunkCompression(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create one compressed and one uncompressed chunk
+	start := time.Date(2023, 8, 3, 0, 0, 0, 0, time.UTC)
+	chunks := []*models.ChunkCompression{
+		{
+			Chunk:                  "_timescaledb_internal._hyper_1_1_chunk",
+			RangeStart:             start,
+			RangeEnd:               start.AddDate(0, 0, 7),
+			Compressed:             true,
+			BytesBeforeCompression: 81920,
+			BytesAfterCompression:  16384,
+			Ratio:                  5,
+		},
+		{
+			Chunk:      "_timescaledb_internal._hyper_1_2_chunk",
+			RangeStart: start.AddDate(0, 0, 7),
+			RangeEnd:   start.AddDate(0, 0, 14),
+		},
+	}
+
+	// Setup expectations
+	mockDB.On("ListChunkCompression", mock.Anything).Return(chunks, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet, "/compression/chunks", io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `[{"chunk":"_timescaledb_internal._hyper_1_1_chunk","rangeStart":"2023-08-03T00:00:00Z",` +
+		`"rangeEnd":"2023-08-10T00:00:00Z","compressed":true,"bytesBeforeCompression":81920,` +
+		`"bytesAfterCompression":16384,"ratio":5},` +
+		`{"chunk":"_timescaledb_internal._hyper_1_2_chunk","rangeStart":"2023-08-10T00:00:00Z",` +
+		`"rangeEnd":"2023-08-17T00:00:00Z","compressed":false}]
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleResampleSensorReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the query the URL parameters should decode into
+	startTime := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	fillValue := -1.0
+	query := models.ResampleQuery{
+		SensorName: "Test Sensor",
+		StartTime:  startTime,
+		EndTime:    startTime.Add(15 * time.Minute),
+		Interval:   models.Duration(5 * time.Minute),
+		Fill:       models.FillConstant,
+		FillValue:  &fillValue,
+	}
+
+	// Create the resampled readings, the second of which was filled in
+	first, third := 20.5, 21.0
+	readings := []*models.ResampledReading{
+		{SensorName: "Test Sensor", Time: startTime, Value: &first},
+		{SensorName: "Test Sensor", Time: startTime.Add(5 * time.Minute), Value: &fillValue, Synthesized: true},
+		{SensorName: "Test Sensor", Time: startTime.Add(10 * time.Minute), Value: &third},
+	}
+
+	// Setup expectations
+	mockDB.On("ResampleSensorReadings", mock.Anything, query).Return(readings, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings:resample?sensorName=Test+Sensor&startTime=2023-08-01T00:00:00Z"+
+			"&endTime=2023-08-01T00:15:00Z&interval=5m&fill=constant&fillValue=-1",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `[{"sensorName":"Test Sensor","time":"2023-08-01T00:00:00Z","value":20.5,"synthesized":false},` +
+		`{"sensorName":"Test Sensor","time":"2023-08-01T00:05:00Z","value":-1,"synthesized":true},` +
+		`{"sensorName":"Test Sensor","time":"2023-08-01T00:10:00Z","value":21,"synthesized":false}]
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleResampleSensorReadingsSensorNotFound(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations: the sensor has been soft-deleted
+	mockDB.On("ResampleSensorReadings", mock.Anything, mock.Anything).
+		Return([]*models.ResampledReading(nil), db.ErrSensorNotFound)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings:resample?sensorName=Test+Sensor&startTime=2023-08-01T00:00:00Z"+
+			"&endTime=2023-08-01T00:15:00Z&interval=5m",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleGetLatestReadings(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the query the URL parameters should decode into
+	query := models.LatestReadingsQuery{SensorNames: []string{"Sensor 1", "Sensor 2"}}
+
+	// Create the latest readings
+	readingTime := time.Date(2023, 8, 16, 12, 0, 0, 0, time.UTC)
+	readings := []*models.SensorReading{
+		{SensorName: "Sensor 1", Value: 20.5, Time: readingTime},
+		{SensorName: "Sensor 2", Value: 18, Time: readingTime.Add(-time.Minute)},
+	}
+
+	// Setup expectations
+	mockDB.On("GetLatestReadings", mock.Anything, query).Return(readings, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet, "/sensor_readings/latest?sensorNames=Sensor+1&sensorNames=Sensor+2",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `[{"sensorName":"Sensor 1","time":"2023-08-16T12:00:00Z","value":20.5},` +
+		`{"sensorName":"Sensor 2","time":"2023-08-16T11:59:00Z","value":18}]
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleGetSensorReadingSeriesWide(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database and a tight series limit
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB, MaxQuerySeries: 40})
+	require.NoError(t, err)
+
+	// Create the query the URL parameters should decode into
+	startTime := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	query := models.SeriesQuery{
+		AllTags:   []string{"building-1", "temperature"},
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+		Layout:    models.SeriesLayoutWide,
+	}
+
+	// Create two series that only share their first timestamp
+	series := []*models.Series{
+		{SensorName: "Sensor 1", Points: []models.SeriesPoint{
+			{Time: startTime, Value: 20},
+			{Time: startTime.Add(time.Minute), Value: 21},
+		}},
+		{SensorName: "Sensor 2", Points: []models.SeriesPoint{
+			{Time: startTime, Value: 18},
+			{Time: startTime.Add(2 * time.Minute), Value: 19},
+		}},
+	}
+
+	// Setup expectations
+	mockDB.On("GetSensorReadingSeries", mock.Anything, query, 40, 100000).Return(series, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/series?allTags=building-1&allTags=temperature&startTime=2023-08-01T00:00:00Z"+
+			"&endTime=2023-08-01T01:00:00Z&layout=wide",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"layout":"wide","columns":["Sensor 1","Sensor 2"],"rows":[` +
+		`{"time":"2023-08-01T00:00:00Z","values":[20,18]},` +
+		`{"time":"2023-08-01T00:01:00Z","values":[21,null]},` +
+		`{"time":"2023-08-01T00:02:00Z","values":[null,19]}]}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleGetSensorReadingSeriesGrouped(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create the query the request body should decode into
+	startTime := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	query := models.SeriesQuery{
+		SensorNames: []string{"Sensor 1", "Sensor 2"},
+		StartTime:   startTime,
+		EndTime:     startTime.Add(time.Hour),
+		Layout:      models.SeriesLayoutGrouped,
+	}
+
+	// Create the series, the second of which has no readings in the range
+	series := []*models.Series{
+		{SensorName: "Sensor 1", Points: []models.SeriesPoint{{Time: startTime, Value: 20}}},
+		{SensorName: "Sensor 2", Points: []models.SeriesPoint{}},
+	}
+
+	// Setup expectations
+	mockDB.On("GetSensorReadingSeries", mock.Anything, query, 100, 100000).Return(series, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodGet, "/sensor_readings/series", bytes.NewBufferString(
+		`{"sensorNames":["Sensor 1","Sensor 2"],"startTime":"2023-08-01T00:00:00Z","endTime":"2023-08-01T01:00:00Z"}`))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"layout":"grouped","series":[` +
+		`{"sensorName":"Sensor 1","points":[{"time":"2023-08-01T00:00:00Z","value":20}]},` +
+		`{"sensorName":"Sensor 2","points":[]}]}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+// exportReadings are the readings returned by the export tests' mock database.
+var exportReadings = []*models.SensorReading{
+	{SensorName: "Sensor 1", Value: 20.5, Time: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Unit: "°C",
+		Quality: models.QualityGood},
+	{SensorName: "Sensor 2", Value: 18, Time: time.Date(2023, 8, 1, 0, 1, 0, 0, time.UTC),
+		Quality: models.QualitySuspect, Annotation: "door open, low"},
+}
+
+func TestHandleExportSensorReadingsCSV(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.MatchedBy(func(q models.ExportQuery) bool {
+		return len(q.AllTags) == 1 && q.AllTags[0] == "temperature" && q.Format == models.ExportFormatCSV
+	}), mock.Anything).Return(exportReadings, nil)
+
+	// Create a new HTTP request asking for CSV in the Accept header
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/export?allTags=temperature&startTime=2023-08-01T00:00:00Z&endTime=2023-08-02T00:00:00Z",
+		io.NopCloser(bytes.NewReader(nil)))
+	req.Header.Set("Accept", "text/csv;q=0.9, */*")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code and content type
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+
+	// Check the response body
+	expected := "time,sensor_name,value,unit,quality,annotation\n" +
+		"2023-08-01T00:00:00Z,Sensor 1,20.5,°C,good,\n" +
+		"2023-08-01T00:01:00Z,Sensor 2,18,,suspect,\"door open, low\"\n"
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleExportSensorReadingsNDJSON(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.MatchedBy(func(q models.ExportQuery) bool {
+		return len(q.SensorNames) == 2 && q.Format == models.ExportFormatNDJSON
+	}), mock.Anything).Return(exportReadings, nil)
+
+	// Create a new HTTP request where the format parameter overrides the Accept header
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/export?sensorNames=Sensor+1&sensorNames=Sensor+2&startTime=2023-08-01T00:00:00Z"+
+			"&endTime=2023-08-02T00:00:00Z&format=ndjson", io.NopCloser(bytes.NewReader(nil)))
+	req.Header.Set("Accept", "text/csv")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code and content type
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+
+	// Check the response body
+	expected := `{"sensorName":"Sensor 1","time":"2023-08-01T00:00:00Z","value":20.5,"unit":"°C","quality":"good"}
+{"sensorName":"Sensor 2","time":"2023-08-01T00:01:00Z","value":18,"quality":"suspect","annotation":"door open, low"}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleExportSensorReadingsParquet(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations
+	mockDB.On("ExportSensorReadings", mock.Anything, mock.Anything, mock.Anything).Return(exportReadings, nil)
+
+	// Create a new HTTP request asking for Parquet in the Accept header
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/export?anyTags=temperature&startTime=2023-08-01T00:00:00Z&endTime=2023-08-02T00:00:00Z",
+		io.NopCloser(bytes.NewReader(nil)))
+	req.Header.Set("Accept", "application/vnd.apache.parquet")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code and content type
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/vnd.apache.parquet", rr.Header().Get("Content-Type"))
+
+	// Read the readings back from the Parquet file
+	reader, err := goparquet.NewFileReader(bytes.NewReader(rr.Body.Bytes()))
+	require.NoError(t, err)
+
+	for _, reading := range exportReadings {
+		row, err := reader.NextRow()
+		require.NoError(t, err)
+		require.Equal(t, reading.Time.UnixMicro(), row["time"])
+		require.Equal(t, []byte(reading.SensorName), row["sensor_name"])
+		require.Equal(t, reading.Value, row["value"])
+		require.Equal(t, []byte(reading.Quality), row["quality"])
+
+		// the optional columns are missing from the row when null
+		if reading.Unit != "" {
+			require.Equal(t, []byte(reading.Unit), row["unit"])
+		} else {
+			require.NotContains(t, row, "unit")
+		}
+
+		if reading.Annotation != "" {
+			require.Equal(t, []byte(reading.Annotation), row["annotation"])
+		} else {
+			require.NotContains(t, row, "annotation")
+		}
+	}
+
+	_, err = reader.NextRow()
+	require.Equal(t, io.EOF, err)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleExportSensorReadingsMissingSensors(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request that selects no sensors
+	req, _ := http.NewRequest(http.MethodGet,
+		"/sensor_readings/export?startTime=2023-08-01T00:00:00Z&endTime=2023-08-02T00:00:00Z",
+		io.NopCloser(bytes.NewReader(nil)))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The database is never queried
+	mockDB.AssertNotCalled(t, "ExportSensorReadings", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleImportSensorReadingsCSV(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a CSV file with its columns out of order and two malformed lines
+	file := "value,time,sensor_name\n" +
+		"20.5,2023-08-01T00:00:00Z,Sensor 1\n" +
+		"abc,2023-08-01T00:01:00Z,Sensor 1\n" +
+		"18,2023-08-01T00:02:00Z,New Sensor\n" +
+		"19,yesterday,New Sensor\n" +
+		"0,2023-08-01T00:03:00Z,Unknown Sensor\n"
+
+	// Setup expectations: the missing sensors are created, and the last reading is a duplicate
+	mockDB.On("CreateMissingSensors", mock.Anything, []string{"Sensor 1", "New Sensor", "Unknown Sensor"}).
+		Return([]string{"New Sensor", "Unknown Sensor"}, nil)
+	mockDB.On("CreateSensorReadings", mock.Anything, mock.MatchedBy(func(readings []*models.SensorReading) bool {
+		return len(readings) == 3 && readings[0].Value == 20.5 && readings[2].Value == 0
+	}), false).Return([]error{nil, nil, db.ErrDuplicateReading}, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:import?createSensors=true",
+		strings.NewReader(file))
+	req.Header.Set("Content-Type", "text/csv")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the report
+	var report models.ImportReport
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+	require.Equal(t, int64(2), report.Accepted)
+	require.Equal(t, int64(3), report.Rejected)
+	require.Equal(t, []string{"New Sensor", "Unknown Sensor"}, report.CreatedSensors)
+	require.Equal(t, []models.ImportRejection{
+		{Line: 3, Error: `invalid value "abc"`},
+		{Line: 5, Error: `invalid time "yesterday", expected RFC 3339`},
+		{Line: 6, Error: db.ErrDuplicateReading.Error()},
+	}, report.Rejections)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleImportSensorReadingsSoftDeletedSensor(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Setup expectations: the soft-deleted sensor is not created again, so its reading is refused
+	mockDB.On("CreateMissingSensors", mock.Anything, []string{"Sensor 1", "Deleted Sensor"}).
+		Return([]string{}, nil)
+	mockDB.On("CreateSensorReadings", mock.Anything, mock.Anything, false).
+		Return([]error{nil, db.ErrSensorNotFound}, nil)
+
+	// Create a new HTTP request
+	file := "sensor_name,time,value\n" +
+		"Sensor 1,2023-08-01T00:00:00Z,20.5\n" +
+		"Deleted Sensor,2023-08-01T00:00:00Z,18\n"
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:import?createSensors=true",
+		strings.NewReader(file))
+	req.Header.Set("Content-Type", "text/csv")
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the report
+	var report models.ImportReport
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+	require.Equal(t, int64(1), report.Accepted)
+	require.Equal(t, []string{}, report.CreatedSensors)
+	require.Equal(t, []models.ImportRejection{{Line: 3, Error: "sensor has been deleted"}}, report.Rejections)
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+}
+
+func TestHandleImportSensorReadingsNDJSONUpload(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a multipart upload of an NDJSON file whose format is only known from its extension
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "readings.ndjson")
+	require.NoError(t, err)
+	_, err = part.Write([]byte(`{"sensorName":"Sensor 1","time":"2023-08-01T00:00:00Z","value":20.5}
+
+{"sensorName":"Sensor 1","time":"2023-08-01T00:01:00Z"}
+{"sensorName":"Unknown Sensor","time":"2023-08-01T00:02:00Z","value":3}
+`))
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	// Setup expectations: sensors are not created, so the unknown sensor's reading is rejected
+	mockDB.On("CreateSensorReadings", mock.Anything, mock.MatchedBy(func(readings []*models.SensorReading) bool {
+		return len(readings) == 2 && readings[1].SensorName == "Unknown Sensor"
+	}), false).Return([]error{nil, db.ErrSensorNotFound}, nil)
+
+	// Create a new HTTP request
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:import", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Check the response body
+	expected := `{"accepted":1,"rejected":2,"createdSensors":[],"rejections":[` +
+		`{"line":3,"error":"missing required fields"},{"line":4,"error":"sensor not found"}]}
+`
+	require.Equal(t, expected, rr.Body.String())
+
+	// Assert that the expectations were met
+	mockDB.AssertExpectations(t)
+	mockDB.AssertNotCalled(t, "CreateMissingSensors", mock.Anything, mock.Anything)
+}
+
+func TestHandleImportSensorReadingsBadHeader(t *testing.T) {
+	// Create a new instance of our mock Db
+	mockDB := new(MockDb)
+
+	// Create a new HTTP server with the mock database
+	svr, err := server.NewHTTPServer(&server.HttpConfig{Port: 8080, Db: mockDB})
+	require.NoError(t, err)
+
+	// Create a new HTTP request with a CSV file that has no header
+	req, _ := http.NewRequest(http.MethodPost, "/sensor_readings:import?format=csv",
+		strings.NewReader("Sensor 1,2023-08-01T00:00:00Z,20.5\n"))
+
+	// Create a ResponseRecorder to record the response
+	rr := httptest.NewRecorder()
+
+	// Serve the request using the router
+	svr.Handler.ServeHTTP(rr, req)
+
+	// Check the status code
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The database is never written to
+	mockDB.AssertNotCalled(t, "CreateSensorReadings", mock.Anything, mock.Anything, mock.Anything)
+}
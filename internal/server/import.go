@@ -0,0 +1,416 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/koneal2013/sensorsphere/internal/db"
+	"github.com/koneal2013/sensorsphere/internal/middleware/adaptor"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const (
+	// importBatchSize is how many readings of an import are written to the database at a time.
+	importBatchSize = 5000
+	// maxImportRejections caps how many rejected lines an import report lists.
+	maxImportRejections = 1000
+	// maxImportLineLength is the longest NDJSON line an import accepts.
+	maxImportLineLength = 1 << 20
+)
+
+// importTypes maps the media types and file extensions of an upload to an import format.
+var importTypes = map[string]models.ImportFormat{
+	"text/csv":             models.ImportFormatCSV,
+	"application/x-ndjson": models.ImportFormatNDJSON,
+	"application/ndjson":   models.ImportFormatNDJSON,
+	"application/jsonl":    models.ImportFormatNDJSON,
+	".csv":                 models.ImportFormatCSV,
+	".ndjson":              models.ImportFormatNDJSON,
+	".jsonl":               models.ImportFormatNDJSON,
+}
+
+// @Summary Import sensor readings
+// @Description Load readings from a CSV file (with a header naming the sensor_name, time and value columns) or
+// @Description from newline-delimited JSON readings, sent as the request body or as the "file" part of a
+// @Description multipart form. The format parameter overrides the Content-Type of the upload. Readings are
+// @Description written with COPY in batches as the upload is read, and the report lists the lines that were
+// @Description rejected. With createSensors, sensors that do not exist yet are created without a location;
+// @Description soft-deleted sensors are not restored and their readings are rejected.
+// @Tags sensor_readings
+// @Accept  text/csv
+// @Accept  application/x-ndjson
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param format query string false "Format of the upload" Enums(csv, ndjson)
+// @Param createSensors query bool false "Create the sensors that do not exist yet"
+// @Success 200 {object} models.ImportReport
+// @Router /sensor_readings:import [post]
+func (s *SensorSphere) HandleImportSensorReadings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.HttpTracer.Start(r.Context(), "HandleImportSensorReadings")
+	defer span.End()
+
+	report, err := s.importSensorReadings(ctx, r)
+	if err != nil {
+		code := http.StatusInternalServerError
+
+		var httpErr *adaptor.HttpError
+		if errors.As(err, &httpErr) {
+			code = httpErr.Code
+		}
+
+		http.Error(w, err.Error(), code)
+		zap.L().Sugar().Error(err, r)
+
+		return
+	}
+
+	if err = adaptor.GenericEncoder(w, report); err != nil {
+		zap.L().Sugar().Error(err, r)
+	}
+}
+
+func (s *SensorSphere) importSensorReadings(ctx context.Context, r *http.Request) (*models.ImportReport, error) {
+	query := r.URL.Query()
+
+	createSensors := false
+	if param := query.Get("createSensors"); param != "" {
+		var err error
+		if createSensors, err = strconv.ParseBool(param); err != nil {
+			return nil, adaptor.NewHttpError(http.StatusBadRequest, fmt.Errorf("invalid createSensors: %w", err))
+		}
+	}
+
+	body, contentType, fileName, err := importUpload(r)
+	if err != nil {
+		return nil, adaptor.NewHttpError(http.StatusBadRequest, err)
+	}
+
+	format, err := importFormat(models.ImportFormat(query.Get("format")), contentType, fileName)
+	if err != nil {
+		return nil, adaptor.NewHttpError(http.StatusBadRequest, err)
+	}
+
+	decoder := newImportDecoder(format, body)
+
+	// imports load historical readings, so only the bound on future timestamps applies
+	window := ReadingTimeWindow{MaxFuture: s.readingTimeWindow.MaxFuture}
+
+	return importSensorReadings(ctx, s.database, window, decoder, createSensors)
+}
+
+// importUpload returns the uploaded file: the "file" part of a multipart form, or else the request body.
+func importUpload(r *http.Request) (body io.Reader, contentType, fileName string, err error) {
+	contentType = r.Header.Get("Content-Type")
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "multipart/form-data" {
+		return r.Body, contentType, "", nil
+	}
+
+	parts, err := r.MultipartReader()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			return nil, "", "", errors.New(`multipart form has no "file" part`)
+		} else if err != nil {
+			return nil, "", "", err
+		}
+
+		if part.FormName() == "file" {
+			return part, part.Header.Get("Content-Type"), part.FileName(), nil
+		}
+	}
+}
+
+// importFormat picks the format of an upload from the format parameter, else its content type, else the
+// extension of its file name.
+func importFormat(format models.ImportFormat, contentType, fileName string) (models.ImportFormat, error) {
+	switch format {
+	case models.ImportFormatCSV, models.ImportFormatNDJSON:
+		return format, nil
+	case "":
+	default:
+		return "", errors.New("format must be csv or ndjson")
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if format, ok := importTypes[mediaType]; ok {
+			return format, nil
+		}
+	}
+
+	if format, ok := importTypes[strings.ToLower(path.Ext(fileName))]; ok {
+		return format, nil
+	}
+
+	return "", errors.New("set format to csv or ndjson, or upload the file as text/csv or application/x-ndjson")
+}
+
+// importSensorReadings reads the readings of an upload and writes them in batches, auto-creating missing
+// sensors when createSensors is set. Soft-deleted sensors are not revived, so their readings are rejected.
+// Batches are committed independently, so when the upload turns out to be unreadable part way through, the
+// readings before that point have been stored.
+func importSensorReadings(ctx context.Context, database db.Database, window ReadingTimeWindow,
+	decoder importDecoder, createSensors bool,
+) (*models.ImportReport, error) {
+	report := &models.ImportReport{CreatedSensors: []string{}, Rejections: []models.ImportRejection{}}
+
+	reject := func(line int, err error) {
+		report.Rejected++
+		if len(report.Rejections) < maxImportRejections {
+			report.Rejections = append(report.Rejections, models.ImportRejection{Line: line, Error: err.Error()})
+		} else {
+			report.RejectionsTruncated = true
+		}
+	}
+
+	batch := make([]*models.SensorReading, 0, importBatchSize)
+	lines := make([]int, 0, importBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if createSensors {
+			names := make([]string, len(batch))
+			for i, reading := range batch {
+				names[i] = reading.SensorName
+			}
+
+			created, err := database.CreateMissingSensors(ctx, names)
+			if err != nil {
+				return err
+			}
+
+			report.CreatedSensors = append(report.CreatedSensors, created...)
+		}
+
+		itemErrs, err := database.CreateSensorReadings(ctx, batch, false)
+		if err != nil {
+			return err
+		}
+
+		for i, err := range itemErrs {
+			// every sensor exists once the missing ones are created, so a sensor not found was soft-deleted
+			if createSensors && errors.Is(err, db.ErrSensorNotFound) {
+				err = db.ErrSensorDeleted
+			}
+
+			if err != nil {
+				reject(lines[i], err)
+			} else {
+				report.Accepted++
+			}
+		}
+
+		batch, lines = batch[:0], lines[:0]
+
+		return nil
+	}
+
+	now := time.Now()
+
+	for {
+		record, err := decoder.next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, adaptor.NewHttpError(http.StatusBadRequest,
+				fmt.Errorf("%w (%d readings were stored before the import stopped)", err, report.Accepted))
+		}
+
+		if record.err == nil {
+			record.err = window.Check(record.reading.Time, now)
+		}
+
+		if record.err != nil {
+			reject(record.line, record.err)
+			continue
+		}
+
+		batch = append(batch, record.reading)
+		lines = append(lines, record.line)
+
+		if len(batch) == importBatchSize {
+			if err = flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// importRecord is a line of an upload: either a reading or the reason the line was rejected.
+type importRecord struct {
+	line    int
+	reading *models.SensorReading
+	err     error
+}
+
+// importDecoder reads an upload one record at a time. It returns io.EOF at the end of the upload and any other
+// error when the upload cannot be read any further.
+type importDecoder interface {
+	next() (importRecord, error)
+}
+
+func newImportDecoder(format models.ImportFormat, r io.Reader) importDecoder {
+	if format == models.ImportFormatNDJSON {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineLength)
+
+		return &ndjsonImportDecoder{scanner: scanner}
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	return &csvImportDecoder{reader: reader}
+}
+
+// csvImportDecoder reads CSV with a header row naming its sensor_name (or name), time (or timestamp) and value
+// columns, in any order. Other columns are ignored.
+type csvImportDecoder struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func (d *csvImportDecoder) next() (importRecord, error) {
+	if d.columns == nil {
+		if err := d.readHeader(); err != nil {
+			return importRecord{}, err
+		}
+	}
+
+	fields, err := d.reader.Read()
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return importRecord{line: parseErr.StartLine, err: parseErr.Err}, nil
+	} else if err != nil {
+		return importRecord{}, err
+	}
+
+	line, _ := d.reader.FieldPos(0)
+	record := importRecord{line: line}
+
+	field := func(column string) string {
+		if i := d.columns[column]; i < len(fields) {
+			return strings.TrimSpace(fields[i])
+		}
+
+		return ""
+	}
+
+	name, readingTime, value := field("sensor_name"), field("time"), field("value")
+	if name == "" || readingTime == "" || value == "" {
+		record.err = errMissingFields
+		return record, nil
+	}
+
+	record.reading = &models.SensorReading{SensorName: name}
+
+	if record.reading.Time, err = time.Parse(time.RFC3339Nano, readingTime); err != nil {
+		record.err = fmt.Errorf("invalid time %q, expected RFC 3339", readingTime)
+	} else if record.reading.Value, err = strconv.ParseFloat(value, 64); err != nil {
+		record.err = fmt.Errorf("invalid value %q", value)
+	}
+
+	return record, nil
+}
+
+var csvImportColumns = map[string]string{
+	"sensor_name": "sensor_name",
+	"sensorname":  "sensor_name",
+	"name":        "sensor_name",
+	"time":        "time",
+	"timestamp":   "time",
+	"value":       "value",
+}
+
+func (d *csvImportDecoder) readHeader() error {
+	header, err := d.reader.Read()
+	if err == io.EOF {
+		return errors.New("the CSV file is empty")
+	} else if err != nil {
+		return err
+	}
+
+	d.columns = map[string]int{}
+
+	for i, name := range header {
+		if column, ok := csvImportColumns[strings.ToLower(strings.TrimSpace(name))]; ok {
+			d.columns[column] = i
+		}
+	}
+
+	if len(d.columns) < 3 {
+		return errors.New("the CSV header must name the sensor_name, time and value columns")
+	}
+
+	return nil
+}
+
+// ndjsonImportDecoder reads one JSON reading per line, skipping blank lines.
+type ndjsonImportDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (d *ndjsonImportDecoder) next() (importRecord, error) {
+	for d.scanner.Scan() {
+		d.line++
+
+		text := strings.TrimSpace(d.scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		record := importRecord{line: d.line}
+
+		var in struct {
+			SensorName string    `json:"sensorName"`
+			Time       time.Time `json:"time"`
+			Value      *float64  `json:"value"`
+		}
+
+		if err := json.Unmarshal([]byte(text), &in); err != nil {
+			record.err = err
+		} else if in.SensorName == "" || in.Time.IsZero() || in.Value == nil {
+			record.err = errMissingFields
+		} else {
+			record.reading = &models.SensorReading{SensorName: in.SensorName, Time: in.Time, Value: *in.Value}
+		}
+
+		return record, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return importRecord{}, fmt.Errorf("line %d: %w", d.line+1, err)
+	}
+
+	return importRecord{}, io.EOF
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/interpolation"
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const (
+	// defaultInterpolationSensors is how many sensors a value is interpolated from unless the query says.
+	defaultInterpolationSensors = 8
+	// maxInterpolationSensors caps how many sensors a value can be interpolated from.
+	maxInterpolationSensors = 100
+	// defaultInterpolationWindow is how far from the query's time readings are looked for unless it says.
+	defaultInterpolationWindow = 15 * time.Minute
+	// defaultIDWPower is the usual inverse distance weighting exponent.
+	defaultIDWPower = 2
+	// maxIDWPower keeps the inverse distance weights from underflowing.
+	maxIDWPower = 10
+)
+
+var (
+	errNoInterpolationSources = errors.New("no sensor has a reading near that place and time")
+	errMixedUnits             = errors.New("the sensors report in different units")
+)
+
+// validateInterpolationQuery checks query and fills in its defaults. The time defaults to now.
+func validateInterpolationQuery(query *models.InterpolationQuery) error {
+	if query.Latitude == 0.0 || query.Longitude == 0.0 {
+		return errMissingFields
+	}
+
+	if err := validateCoordinates(query.Longitude, query.Latitude); err != nil {
+		return err
+	}
+
+	if query.Time.IsZero() {
+		query.Time = time.Now()
+	}
+
+	switch query.Method {
+	case "":
+		query.Method = models.InterpolationIDW
+	case models.InterpolationIDW, models.InterpolationNearest, models.InterpolationKriging:
+	default:
+		return fmt.Errorf("invalid interpolation method %q", query.Method)
+	}
+
+	switch {
+	case query.K == 0:
+		query.K = defaultInterpolationSensors
+	case query.K < 0 || query.K > maxInterpolationSensors:
+		return fmt.Errorf("k must be between 1 and %d", maxInterpolationSensors)
+	}
+
+	switch {
+	case query.Window == 0:
+		query.Window = models.Duration(defaultInterpolationWindow)
+	case query.Window < 0:
+		return errors.New("window must not be negative")
+	}
+
+	if query.MaxDistance < 0 {
+		return errors.New("maxDistance must not be negative")
+	}
+
+	switch {
+	case query.Power == 0:
+		query.Power = defaultIDWPower
+	case query.Power < 0 || query.Power > maxIDWPower:
+		return fmt.Errorf("power must be between 0 and %d", maxIDWPower)
+	}
+
+	if query.Range < 0 {
+		return errors.New("range must not be negative")
+	}
+
+	return nil
+}
+
+// interpolate estimates the value at the query's point from the readings of sources using the query's method,
+// and sets the weight of each source.
+func interpolate(query models.InterpolationQuery,
+	sources []*models.InterpolationSource,
+) (*models.Interpolation, error) {
+	if len(sources) == 0 {
+		return nil, errNoInterpolationSources
+	}
+
+	// sensors without a unit are taken to report in the unit of the others
+	var unit string
+	for _, source := range sources {
+		if source.Unit == "" {
+			continue
+		}
+
+		if unit != "" && source.Unit != unit {
+			return nil, fmt.Errorf("%w (%s and %s); narrow the sensors with measurementType or tags",
+				errMixedUnits, unit, source.Unit)
+		}
+
+		unit = source.Unit
+	}
+
+	samples := make([]interpolation.Sample, len(sources))
+	for i, source := range sources {
+		x, y := interpolation.Project(query.Longitude, query.Latitude, source.Location.Longitude,
+			source.Location.Latitude)
+		samples[i] = interpolation.Sample{X: x, Y: y, Value: source.Value}
+	}
+
+	var (
+		estimate interpolation.Estimate
+		err      error
+	)
+
+	switch query.Method {
+	case models.InterpolationNearest:
+		estimate, err = interpolation.NearestNeighbour(samples)
+	case models.InterpolationKriging:
+		estimate, err = interpolation.OrdinaryKriging(samples, query.Range)
+	default:
+		estimate, err = interpolation.InverseDistance(samples, query.Power)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, source := range sources {
+		source.Weight = estimate.Weights[i]
+	}
+
+	result := &models.Interpolation{
+		Value:   estimate.Value,
+		Unit:    unit,
+		Method:  query.Method,
+		Sensors: sources,
+	}
+
+	if query.Method == models.InterpolationKriging {
+		result.Variance = &estimate.Variance
+	}
+
+	return result, nil
+}
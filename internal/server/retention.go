@@ -0,0 +1,29 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+func validateRetentionPolicy(policy *models.RetentionPolicy) error {
+	switch policy.Scope {
+	case models.RetentionScopeGlobal:
+		if policy.Target != "" {
+			return errors.New("the global retention policy has no target")
+		}
+	case models.RetentionScopeTag, models.RetentionScopeSensor:
+		if policy.Target == "" {
+			return fmt.Errorf("a %s retention policy needs a target", policy.Scope)
+		}
+	default:
+		return fmt.Errorf("invalid retention scope %q", policy.Scope)
+	}
+
+	if policy.MaxAge <= 0 {
+		return errors.New("maxAge must be positive")
+	}
+
+	return nil
+}
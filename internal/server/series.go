@@ -0,0 +1,100 @@
+package server
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const (
+	defaultMaxQuerySeries = 100
+	maxSeriesReadings     = 100000
+)
+
+// validateSeriesQuery checks query and fills in its defaults.
+func validateSeriesQuery(query *models.SeriesQuery) error {
+	if len(query.SensorNames) == 0 && len(query.AnyTags) == 0 && len(query.AllTags) == 0 {
+		return errMissingFields
+	}
+
+	if query.StartTime.IsZero() || query.EndTime.IsZero() {
+		return errMissingFields
+	}
+
+	if query.EndTime.Before(query.StartTime) {
+		return errors.New("endTime must not be before startTime")
+	}
+
+	switch query.Layout {
+	case "":
+		query.Layout = models.SeriesLayoutGrouped
+	case models.SeriesLayoutGrouped, models.SeriesLayoutWide:
+	default:
+		return errors.New("layout must be grouped or wide")
+	}
+
+	return nil
+}
+
+// maxQuerySeriesOrDefault treats a zero limit as defaultMaxQuerySeries.
+func maxQuerySeriesOrDefault(maxSeries int) int {
+	if maxSeries <= 0 {
+		return defaultMaxQuerySeries
+	}
+
+	return maxSeries
+}
+
+// layoutSeries arranges series as requested by layout.
+func layoutSeries(layout models.SeriesLayout, series []*models.Series) *models.SeriesResponse {
+	if layout == models.SeriesLayoutWide {
+		return wideSeries(series)
+	}
+
+	return &models.SeriesResponse{Layout: models.SeriesLayoutGrouped, Series: series}
+}
+
+// wideSeries turns series into one row per distinct timestamp, in time order, with a column per series. Units
+// are listed per column when any of the sensors has one.
+func wideSeries(series []*models.Series) *models.SeriesResponse {
+	res := &models.SeriesResponse{
+		Layout:  models.SeriesLayoutWide,
+		Columns: make([]string, len(series)),
+		Rows:    []*models.WideRow{},
+	}
+
+	rowsByTime := map[time.Time]*models.WideRow{}
+	units := make([]string, len(series))
+
+	for column, s := range series {
+		res.Columns[column] = s.SensorName
+		units[column] = s.Unit
+
+		if s.Unit != "" {
+			res.Units = units
+		}
+
+		for _, point := range s.Points {
+			// normalise the location so that equal instants share a row
+			t := point.Time.UTC()
+
+			row, ok := rowsByTime[t]
+			if !ok {
+				row = &models.WideRow{Time: t, Values: make([]*float64, len(series))}
+				rowsByTime[t] = row
+				res.Rows = append(res.Rows, row)
+			}
+
+			value := point.Value
+			row.Values[column] = &value
+		}
+	}
+
+	sort.Slice(res.Rows, func(i, j int) bool {
+		return res.Rows[i].Time.Before(res.Rows[j].Time)
+	})
+
+	return res
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+// maxNearestSensors caps k in nearest sensor queries.
+const maxNearestSensors = 1000
+
+// validateNearestQuery checks query and fills in its defaults: k is 1 unless given.
+func validateNearestQuery(query *models.NearestQuery) error {
+	if query.Latitude == 0.0 || query.Longitude == 0.0 {
+		return errMissingFields
+	}
+
+	if err := validateCoordinates(query.Longitude, query.Latitude); err != nil {
+		return err
+	}
+
+	switch {
+	case query.K == 0:
+		query.K = 1
+	case query.K < 0 || query.K > maxNearestSensors:
+		return fmt.Errorf("k must be between 1 and %d", maxNearestSensors)
+	}
+
+	if query.MaxDistance < 0 {
+		return errors.New("maxDistance must not be negative")
+	}
+
+	if query.ReportedWithin < 0 {
+		return errors.New("reportedWithin must not be negative")
+	}
+
+	return nil
+}
+
+// maxSearchRadius is the widest radius search, in metres: half the Earth's circumference reaches every point.
+const maxSearchRadius = 20037508.0
+
+func validateRadiusQuery(query models.RadiusQuery) error {
+	if query.Latitude == 0.0 || query.Longitude == 0.0 || query.Radius == 0.0 {
+		return errMissingFields
+	}
+
+	if err := validateCoordinates(query.Longitude, query.Latitude); err != nil {
+		return err
+	}
+
+	if query.Radius < 0 || query.Radius > maxSearchRadius {
+		return errors.New("radius must be between 0 and 20037508 metres")
+	}
+
+	return nil
+}
+
+// maxAreaVertices caps the size of the areas sensors can be searched in.
+const maxAreaVertices = 10000
+
+// validateAreaQuery checks that query has either a bounding box or a geometry and parses the geometry into
+// query.Area.
+func validateAreaQuery(query *models.AreaQuery) error {
+	switch {
+	case query.BoundingBox != nil && len(query.Geometry) > 0:
+		return errors.New("give either bbox or geometry, not both")
+	case query.BoundingBox != nil:
+		return validateBoundingBox(*query.BoundingBox)
+	case len(query.Geometry) > 0:
+		area, err := parseGeoJSONArea(query.Geometry)
+		if err != nil {
+			return err
+		}
+
+		query.Area = area
+
+		return nil
+	default:
+		return errMissingFields
+	}
+}
+
+// validateBoundingBox checks box's coordinates. Its minimum longitude may be greater than its maximum, for a box
+// crossing the antimeridian, but its minimum latitude may not.
+func validateBoundingBox(box models.BoundingBox) error {
+	if err := validateCoordinates(box.MinLongitude, box.MinLatitude); err != nil {
+		return err
+	}
+
+	if err := validateCoordinates(box.MaxLongitude, box.MaxLatitude); err != nil {
+		return err
+	}
+
+	if box.MinLatitude > box.MaxLatitude {
+		return errors.New("bbox minLatitude must not be greater than its maxLatitude")
+	}
+
+	return nil
+}
+
+// parseGeoJSONArea parses a GeoJSON Polygon or MultiPolygon geometry, or a Feature holding one, and checks
+// that its rings are closed and its coordinates in range.
+func parseGeoJSONArea(data []byte) (geom.T, error) {
+	var object struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+
+	var area geom.T
+
+	if object.Type == "Feature" {
+		var feature geojson.Feature
+		if err := json.Unmarshal(data, &feature); err != nil {
+			return nil, fmt.Errorf("invalid geometry: %w", err)
+		}
+
+		area = feature.Geometry
+	} else if err := geojson.Unmarshal(data, &area); err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+
+	var polygons []*geom.Polygon
+
+	switch area := area.(type) {
+	case *geom.Polygon:
+		polygons = []*geom.Polygon{area}
+	case *geom.MultiPolygon:
+		for i := 0; i < area.NumPolygons(); i++ {
+			polygons = append(polygons, area.Polygon(i))
+		}
+	default:
+		return nil, errors.New("geometry must be a GeoJSON Polygon or MultiPolygon")
+	}
+
+	if vertices := len(area.FlatCoords()) / area.Stride(); vertices > maxAreaVertices {
+		return nil, fmt.Errorf("geometry has %d vertices, more than the limit of %d", vertices, maxAreaVertices)
+	}
+
+	for _, polygon := range polygons {
+		if polygon.NumLinearRings() == 0 {
+			return nil, errors.New("polygons must have at least one ring")
+		}
+
+		for i := 0; i < polygon.NumLinearRings(); i++ {
+			if err := validateRing(polygon.LinearRing(i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return area, nil
+}
+
+func validateRing(ring *geom.LinearRing) error {
+	n := ring.NumCoords()
+	if n < 4 {
+		return errors.New("polygon rings need at least four positions")
+	}
+
+	first, last := ring.Coord(0), ring.Coord(n-1)
+	if first.X() != last.X() || first.Y() != last.Y() {
+		return errors.New("polygon rings must end where they start")
+	}
+
+	for i := 0; i < n; i++ {
+		if err := validateCoordinates(ring.Coord(i).X(), ring.Coord(i).Y()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCoordinates(longitude, latitude float64) error {
+	if longitude < -180 || longitude > 180 || latitude < -90 || latitude > 90 {
+		return errors.New("longitude must be between -180 and 180 and latitude between -90 and 90")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/koneal2013/sensorsphere/internal/models"
+)
+
+const maxLatestSensorNames = 1000
+
+var ErrReadingOutsideTimeWindow = errors.New("reading time is outside the accepted window")
+
+// ReadingTimeWindow bounds how far a client-supplied reading timestamp may be from server time.
+// A zero bound leaves that side of the window open.
+type ReadingTimeWindow struct {
+	MaxFuture time.Duration
+	MaxPast   time.Duration
+}
+
+// Check validates readingTime against the window around now. Zero times are accepted because the
+// database substitutes its own clock for them.
+func (w ReadingTimeWindow) Check(readingTime, now time.Time) error {
+	if readingTime.IsZero() {
+		return nil
+	}
+
+	if w.MaxFuture > 0 && readingTime.After(now.Add(w.MaxFuture)) {
+		return fmt.Errorf("%w: %s is more than %s in the future",
+			ErrReadingOutsideTimeWindow, readingTime.Format(time.RFC3339), w.MaxFuture)
+	}
+
+	if w.MaxPast > 0 && readingTime.Before(now.Add(-w.MaxPast)) {
+		return fmt.Errorf("%w: %s is more than %s in the past",
+			ErrReadingOutsideTimeWindow, readingTime.Format(time.RFC3339), w.MaxPast)
+	}
+
+	return nil
+}
+
+// validateSensorRange checks a sensor's valid range and policy, defaulting the policy to reject.
+func validateSensorRange(sensor *models.Sensor) error {
+	if sensor.MinValue != nil && sensor.MaxValue != nil && *sensor.MinValue > *sensor.MaxValue {
+		return errors.New("minValue must not be greater than maxValue")
+	}
+
+	switch sensor.RangePolicy {
+	case "":
+		sensor.RangePolicy = models.RangePolicyReject
+	case models.RangePolicyReject, models.RangePolicyFlag:
+	default:
+		return fmt.Errorf("invalid range policy %q", sensor.RangePolicy)
+	}
+
+	return nil
+}
+
+// validateQuality accepts the quality codes, and no quality at all.
+func validateQuality(quality models.Quality) error {
+	if quality != "" && !quality.Valid() {
+		return fmt.Errorf("invalid quality %q", quality)
+	}
+
+	return nil
+}
+
+func validateFlagReadingsRequest(req models.FlagReadingsRequest) error {
+	if req.SensorName == "" || req.StartTime.IsZero() || req.EndTime.IsZero() || req.Quality == "" {
+		return errMissingFields
+	}
+
+	if req.EndTime.Before(req.StartTime) {
+		return errors.New("endTime must not be before startTime")
+	}
+
+	return validateQuality(req.Quality)
+}
+
+func validateLatestReadingsQuery(query models.LatestReadingsQuery) error {
+	if len(query.SensorNames) == 0 && len(query.Tags) == 0 {
+		return errMissingFields
+	}
+
+	if len(query.SensorNames) > maxLatestSensorNames {
+		return fmt.Errorf("%d sensor names exceed the limit of %d", len(query.SensorNames), maxLatestSensorNames)
+	}
+
+	return nil
+}